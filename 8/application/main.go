@@ -30,7 +30,7 @@ func ExampleComplexSchema() {
 	userSchema := schemaBuilder.Object(map[string]validation.AnyValidator{
 		"id":       schemaBuilder.String().WithMessage("ID must be a string"),
 		"name":     schemaBuilder.String().MinLength(2).MaxLength(50),
-		"email":    schemaBuilder.String().Pattern(`^[^\s@]+@[^\s@]+\.[^\s@]+$`),
+		"email":    schemaBuilder.String().Email(),
 		"age":      schemaBuilder.Number().Optional(),
 		"isActive": schemaBuilder.Boolean(),
 		"tags":     schemaBuilder.Array(schemaBuilder.String()),
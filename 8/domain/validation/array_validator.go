@@ -1,17 +1,82 @@
 package validation
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // ArrayValidator validates array values
 type ArrayValidator[T any] struct {
 	BaseValidator
 	ItemValidator AnyValidator
+	tuple         []AnyValidator
+	minItems      *int
+	maxItems      *int
+	unique        bool
+	uniqueKey     func(item any) any
+	contains      AnyValidator
+	minContains   *int
+	maxContains   *int
+}
+
+// ArrayConstraints describes the constraints configured on an ArrayValidator
+type ArrayConstraints struct {
+	MinItems *int
+	MaxItems *int
+	// Unique is set by Unique and UniqueBy; UniqueByKey tells them apart
+	Unique      bool
+	UniqueByKey bool
+	Contains    AnyValidator
+	MinContains *int
+	MaxContains *int
+}
+
+// Items returns the validator applied to each element, nil when any item is
+// accepted. For tuples it is the validator of the items after the fixed
+// positions
+func (a *ArrayValidator[T]) Items() AnyValidator {
+	return a.ItemValidator
+}
+
+// TupleItems returns the validators of the fixed positions set by Tuple
+func (a *ArrayValidator[T]) TupleItems() []AnyValidator {
+	return a.tuple
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (a *ArrayValidator[T]) Constraints() ArrayConstraints {
+	return ArrayConstraints{
+		MinItems:    copyPointer(a.minItems),
+		MaxItems:    copyPointer(a.maxItems),
+		Unique:      a.unique,
+		UniqueByKey: a.uniqueKey != nil,
+		Contains:    a.contains,
+		MinContains: copyPointer(a.minContains),
+		MaxContains: copyPointer(a.maxContains),
+	}
 }
 
 func (a *ArrayValidator[T]) Validate(value any) ValidationResult {
+	return a.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (a *ArrayValidator[T]) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return a.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (a *ArrayValidator[T]) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = a.applyDefault(value)
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if a.isOptional() {
@@ -20,10 +85,7 @@ func (a *ArrayValidator[T]) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: a.getMessage("Array value is required"),
-				},
+				a.newError(opts, CodeArrayRequired, nil),
 			},
 		}
 	}
@@ -34,58 +96,410 @@ func (a *ArrayValidator[T]) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: a.getMessage(fmt.Sprintf("Expected array/slice value, got %T", value)),
-				},
+				a.newError(opts, CodeArrayType,
+					map[string]any{"expected": "array", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
-
-	// If no item validator is provided, the array is valid
-	if a.ItemValidator == nil {
-		return ValidationResult{IsValid: true, Errors: nil}
-	}
-
-	// Validate each item in the array
 	valueReflect := reflect.ValueOf(value)
+	length := valueReflect.Len()
 	var errors []ValidationError
 
-	for i := 0; i < valueReflect.Len(); i++ {
-		item := valueReflect.Index(i).Interface()
-		itemResult := a.ItemValidator.Validate(item)
+	// Check length constraints
+	if a.minItems != nil && length < *a.minItems {
+		errors = append(errors, a.newError(opts, CodeArrayMinItems,
+			map[string]any{"min": *a.minItems, "actual": length}))
+	}
+	if a.maxItems != nil && length > *a.maxItems {
+		errors = append(errors, a.newError(opts, CodeArrayMaxItems,
+			map[string]any{"max": *a.maxItems, "actual": length}))
+	}
+	if a.shouldAbort(errors) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+
+	// Validate each item in the array, concurrently on the context path;
+	// without an item validator any item is accepted. Tuple positions
+	// missing from the value are validated as nil
+	items := make([]any, length)
+	for i := range items {
+		items[i] = indirect(valueReflect.Index(i).Interface())
+	}
+	var jobs []validationJob
+	var indexes []int
+	for i := 0; i < length || i < len(a.tuple); i++ {
+		i := i
+		var item any
+		if i < length {
+			item = items[i]
+		}
+		switch {
+		case i < len(a.tuple):
+			jobs = append(jobs, func(opts Options) ValidationResult {
+				return validateWithOptions(a.tuple[i], item, opts)
+			})
+		case a.ItemValidator != nil:
+			jobs = append(jobs, func(opts Options) ValidationResult {
+				return validateWithOptions(a.ItemValidator, item, opts)
+			})
+		case a.tuple != nil:
+			// Tuples without a rest validator have a fixed length
+			jobs = append(jobs, func(opts Options) ValidationResult {
+				return ValidationResult{IsValid: false, Errors: []ValidationError{
+					a.newError(opts, CodeArrayExtraItem, map[string]any{"max": len(a.tuple)}),
+				}}
+			})
+		default:
+			continue
+		}
+		indexes = append(indexes, i)
+	}
 
+	itemErrors := false
+	for j, itemResult := range a.validateAll(jobs, opts) {
+		i := indexes[j]
 		if !itemResult.IsValid {
+			itemErrors = true
 			// Add item index to field path for better error reporting
 			for _, err := range itemResult.Errors {
-				fieldPath := fmt.Sprintf("[%d]", i)
-				if err.Field != "" {
-					fieldPath = fmt.Sprintf("[%d].%s", i, err.Field)
+				errors = append(errors, err.prefixed(Index(i)))
+			}
+			if a.shouldAbort(errors) || isCanceled(itemResult) {
+				return ValidationResult{IsValid: false, Errors: errors}
+			}
+		} else if i < length {
+			items[i] = outputOf(itemResult, items[i])
+		}
+	}
+
+	// Check how many items match the contains validator
+	if a.contains != nil {
+		errors = append(errors, a.checkContains(items, opts)...)
+		if a.shouldAbort(errors) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+	}
+
+	// Check uniqueness once every item is valid, so that key functions can
+	// rely on the item shape; each repeated item is reported at its index
+	if a.unique && !itemErrors {
+		errors = append(errors, a.checkUnique(items, opts)...)
+	}
+
+	// Items may have been transformed; the output keeps the slice type
+	// whenever the item outputs still fit it
+	if len(jobs) > 0 && !itemErrors {
+		value = rebuildSlice(valueReflect, items)
+	}
+
+	// Run custom checks once every item is valid, then transforms
+	if len(errors) == 0 {
+		errors = a.refine(value, errors, opts)
+	}
+	return a.finish(value, errors, opts)
+}
+
+// checkContains counts the items accepted by the contains validator against
+// the MinContains (default 1) and MaxContains bounds
+func (a *ArrayValidator[T]) checkContains(items []any, opts Options) []ValidationError {
+	matches := 0
+	for _, item := range items {
+		if validateWithOptions(a.contains, item, opts).IsValid {
+			matches++
+		}
+	}
+
+	minContains := 1
+	if a.minContains != nil {
+		minContains = *a.minContains
+	}
+	var errors []ValidationError
+	if matches < minContains {
+		errors = append(errors, a.newError(opts, CodeArrayContains,
+			map[string]any{"min": minContains, "actual": matches}))
+	}
+	if a.maxContains != nil && matches > *a.maxContains {
+		errors = append(errors, a.newError(opts, CodeArrayMaxContains,
+			map[string]any{"max": *a.maxContains, "actual": matches}))
+	}
+	return errors
+}
+
+// checkUnique reports every item equal to an earlier one, or whose key is,
+// at the later item's index
+func (a *ArrayValidator[T]) checkUnique(items []any, opts Options) []ValidationError {
+	var errors []ValidationError
+	seen := make(map[string]int, len(items))
+	// others holds the indexes of items that have no canonical form, which
+	// are compared with reflect.DeepEqual instead
+	var others []int
+	keys := make([]any, len(items))
+	for i, item := range items {
+		if a.uniqueKey != nil {
+			item = a.uniqueKey(item)
+		}
+		keys[i] = item
+
+		first, exists := -1, false
+		key, canonical := canonicalKey(item)
+		if canonical {
+			first, exists = seen[key]
+		} else {
+			for _, index := range others {
+				if reflect.DeepEqual(keys[index], item) {
+					first, exists = index, true
+					break
 				}
-				errors = append(errors, ValidationError{
-					Field:   fieldPath,
-					Message: err.Message,
-				})
 			}
 		}
+		if exists {
+			err := a.newError(opts, CodeArrayUnique, map[string]any{"first": first})
+			errors = append(errors, err.prefixed(Index(i)))
+			if a.shouldAbort(errors) {
+				break
+			}
+			continue
+		}
+		if canonical {
+			seen[key] = i
+		} else {
+			others = append(others, i)
+		}
 	}
+	return errors
+}
 
-	if len(errors) > 0 {
-		return ValidationResult{
-			IsValid: false,
-			Errors:  errors,
+// canonicalKey returns a canonical form of a JSON-shaped value (nil,
+// booleans, numbers, strings, and maps with string keys and slices of
+// them) for uniqueness checks. Numbers are written by exact value and map
+// keys are sorted, so 1 and 1.0 get the same key. It reports false for
+// other values, e.g. structs or []byte, whose JSON encoding could make
+// different values look equal
+func canonicalKey(value any) (string, bool) {
+	var builder strings.Builder
+	if !writeCanonical(&builder, reflect.ValueOf(value)) {
+		return "", false
+	}
+	return builder.String(), true
+}
+
+func writeCanonical(builder *strings.Builder, value reflect.Value) bool {
+	if !value.IsValid() {
+		builder.WriteString("null")
+		return true
+	}
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			builder.WriteString("null")
+			return true
+		}
+		value = value.Elem()
+	}
+	if value.Type() == reflect.TypeOf(json.Number("")) {
+		n, ok := parseDecimal(value.String())
+		if !ok {
+			return false
+		}
+		writeNumber(builder, n)
+		return true
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		builder.WriteString(strconv.FormatBool(value.Bool()))
+	case reflect.String:
+		builder.WriteString(strconv.Quote(value.String()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		n, _ := toNumber(value.Interface())
+		writeNumber(builder, n)
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		builder.WriteByte('[')
+		for i := 0; i < value.Len(); i++ {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			if !writeCanonical(builder, value.Index(i)) {
+				return false
+			}
+		}
+		builder.WriteByte(']')
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return false
 		}
+		names := make([]string, 0, value.Len())
+		for _, name := range value.MapKeys() {
+			names = append(names, name.String())
+		}
+		sort.Strings(names)
+		builder.WriteByte('{')
+		for i, name := range names {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteString(strconv.Quote(name))
+			builder.WriteByte(':')
+			if !writeCanonical(builder, value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))) {
+				return false
+			}
+		}
+		builder.WriteByte('}')
+	default:
+		return false
 	}
+	return true
+}
+
+func writeNumber(builder *strings.Builder, n number) {
+	switch {
+	case n.nan:
+		builder.WriteString("NaN")
+	case n.inf != 0:
+		builder.WriteString(strconv.FormatFloat(math.Inf(n.inf), 'g', -1, 64))
+	default:
+		builder.WriteString(n.rat.RatString())
+	}
+}
 
-	return ValidationResult{IsValid: true, Errors: nil}
+// MinItems requires at least length items
+func (a *ArrayValidator[T]) MinItems(length int) *ArrayValidator[T] {
+	a = clone(a)
+	a.minItems = &length
+	return a
+}
+
+// MaxItems allows at most length items
+func (a *ArrayValidator[T]) MaxItems(length int) *ArrayValidator[T] {
+	a = clone(a)
+	a.maxItems = &length
+	return a
+}
+
+// Length requires exactly length items
+func (a *ArrayValidator[T]) Length(length int) *ArrayValidator[T] {
+	return a.MinItems(length).MaxItems(length)
+}
+
+// Unique rejects items equal to an earlier item. Numbers compare by value
+// and maps and slices by content; other values, such as structs, compare
+// with reflect.DeepEqual
+func (a *ArrayValidator[T]) Unique() *ArrayValidator[T] {
+	a = clone(a)
+	a.unique = true
+	a.uniqueKey = nil
+	return a
+}
+
+// UniqueBy rejects items whose key equals the key of an earlier item, e.g.
+// objects sharing an "id"
+func (a *ArrayValidator[T]) UniqueBy(key func(item any) any) *ArrayValidator[T] {
+	a = clone(a)
+	a.unique = true
+	a.uniqueKey = key
+	return a
+}
+
+// Contains requires at least one item accepted by validator; MinContains
+// and MaxContains change the number of matches required
+func (a *ArrayValidator[T]) Contains(validator AnyValidator) *ArrayValidator[T] {
+	a = clone(a)
+	a.contains = validator
+	return a
+}
+
+// MinContains requires at least count items to match the Contains validator
+func (a *ArrayValidator[T]) MinContains(count int) *ArrayValidator[T] {
+	a = clone(a)
+	a.minContains = &count
+	return a
+}
+
+// MaxContains allows at most count items to match the Contains validator
+func (a *ArrayValidator[T]) MaxContains(count int) *ArrayValidator[T] {
+	a = clone(a)
+	a.maxContains = &count
+	return a
+}
+
+// Tuple validates the item at each position with the matching validator,
+// e.g. Tuple(latitude, longitude). Missing positions are validated as nil,
+// so only optional positions may be left out. Extra items are rejected
+// unless Rest sets a validator for them
+func (a *ArrayValidator[T]) Tuple(validators ...AnyValidator) *ArrayValidator[T] {
+	a = clone(a)
+	a.tuple = append([]AnyValidator{}, validators...)
+	return a
+}
+
+// Rest sets the validator for the items after the Tuple positions
+func (a *ArrayValidator[T]) Rest(validator AnyValidator) *ArrayValidator[T] {
+	a = clone(a)
+	a.ItemValidator = validator
+	return a
+}
+
+// Refine adds a custom check that receives the whole array once every item
+// is valid and returns an error, or nil when the value is acceptable. Errors
+// may point at an item by setting Path, e.g. Path{Index(2)}
+func (a *ArrayValidator[T]) Refine(check func(value any) *ValidationError) *ArrayValidator[T] {
+	a = clone(a)
+	a.addRefinement(check)
+	return a
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (a *ArrayValidator[T]) RefineContext(check func(ctx context.Context, value any) *ValidationError) *ArrayValidator[T] {
+	a = clone(a)
+	a.addContextRefinement(check)
+	return a
+}
+
+// Transform adds a function that turns the valid value into the output
+func (a *ArrayValidator[T]) Transform(transform func(value any) (any, error)) *ArrayValidator[T] {
+	a = clone(a)
+	a.addTransform(transform)
+	return a
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (a *ArrayValidator[T]) Default(value T) *ArrayValidator[T] {
+	a = clone(a)
+	a.setDefault(value)
+	return a
+}
+
+// AbortEarly stops validation at the first invalid item
+func (a *ArrayValidator[T]) AbortEarly() *ArrayValidator[T] {
+	a = clone(a)
+	a.setAbortEarly(true)
+	return a
+}
+
+// CollectAll reports errors for every invalid item (the default)
+func (a *ArrayValidator[T]) CollectAll() *ArrayValidator[T] {
+	a = clone(a)
+	a.setAbortEarly(false)
+	return a
 }
 
 func (a *ArrayValidator[T]) Optional() Validator[T] {
+	a = clone(a)
 	a.setOptional()
 	return a
 }
 
+// Nullable also accepts null, see NullableValidator
+func (a *ArrayValidator[T]) Nullable() *NullableValidator {
+	return nullableOf(a, a.optional)
+}
+
 func (a *ArrayValidator[T]) WithMessage(message string) Validator[T] {
+	a = clone(a)
 	a.setMessage(message)
 	return a
 }
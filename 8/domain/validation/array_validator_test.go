@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -80,7 +82,7 @@ func TestArrayValidator_ValidateNilOptional(t *testing.T) {
 	validator := &ArrayValidator[any]{}
 
 	// Test with nil value (optional)
-	validator.Optional()
+	validator = validator.Optional().(*ArrayValidator[any])
 	result := validator.Validate(nil)
 	if !result.IsValid {
 		t.Error("Array validator should accept nil value when optional")
@@ -128,7 +130,7 @@ func TestArrayValidator_WithItemValidator(t *testing.T) {
 func TestArrayValidator_WithItemValidatorComplex(t *testing.T) {
 	// Create a number validator with constraints
 	itemValidator := &NumberValidator{}
-	itemValidator.Min(0).Max(100)
+	itemValidator = itemValidator.Min(0).Max(100)
 
 	validator := &ArrayValidator[any]{
 		ItemValidator: itemValidator,
@@ -159,7 +161,7 @@ func TestArrayValidator_WithItemValidatorComplex(t *testing.T) {
 func TestArrayValidator_WithItemValidatorMultipleErrors(t *testing.T) {
 	// Create a string validator with length constraints
 	itemValidator := &StringValidator{}
-	itemValidator.MinLength(3).MaxLength(10)
+	itemValidator = itemValidator.MinLength(3).MaxLength(10)
 
 	validator := &ArrayValidator[any]{
 		ItemValidator: itemValidator,
@@ -223,13 +225,17 @@ func TestArrayValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
+	}
+	copied := result.(*ArrayValidator[any])
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -240,13 +246,17 @@ func TestArrayValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
 	}
+	copied := result.(*ArrayValidator[any])
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -255,7 +265,7 @@ func TestArrayValidator_WithMessageValidation(t *testing.T) {
 	validator := &ArrayValidator[any]{}
 	customMessage := "Custom array validation message"
 
-	validator.WithMessage(customMessage)
+	validator = validator.WithMessage(customMessage).(*ArrayValidator[any])
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate("not an array")
@@ -278,16 +288,20 @@ func TestArrayValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
 	}
+	copied := result.(*ArrayValidator[any])
 
 	// Should have all properties set
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -353,7 +367,7 @@ func TestArrayValidator_ArrayType(t *testing.T) {
 func TestArrayValidator_ItemValidatorWithNestedFields(t *testing.T) {
 	// Create a string validator with constraints
 	itemValidator := &StringValidator{}
-	itemValidator.MinLength(2).MaxLength(10)
+	itemValidator = itemValidator.MinLength(2).MaxLength(10)
 
 	validator := &ArrayValidator[any]{
 		ItemValidator: itemValidator,
@@ -400,3 +414,227 @@ func TestArrayValidator_ItemValidatorWithNestedFields(t *testing.T) {
 		t.Error("Expected error for index [2] (too long string)")
 	}
 }
+
+func TestArrayValidator_AbortEarly(t *testing.T) {
+	validator := &ArrayValidator[any]{ItemValidator: &StringValidator{}}
+	validator = validator.AbortEarly()
+
+	result := validator.Validate([]interface{}{1, "ok", 2, 3})
+	if result.IsValid {
+		t.Error("Array validator should reject array with invalid items")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error in abort-early mode, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Field != "[0]" {
+		t.Errorf("Expected error field '[0]', got '%s'", result.Errors[0].Field)
+	}
+
+	validator = validator.CollectAll()
+	result = validator.Validate([]interface{}{1, "ok", 2, 3})
+	if len(result.Errors) != 3 {
+		t.Errorf("Expected 3 errors in collect-all mode, got %d", len(result.Errors))
+	}
+}
+
+func TestArrayValidator_Refine(t *testing.T) {
+	validator := (&ArrayValidator[[]any]{ItemValidator: &NumberValidator{}}).Refine(func(value any) *ValidationError {
+		items := value.([]any)
+		for i := 1; i < len(items); i++ {
+			if items[i].(int) < items[i-1].(int) {
+				return &ValidationError{Path: Path{Index(i)}, Message: "Items must be sorted"}
+			}
+		}
+		return nil
+	})
+
+	if result := validator.Validate([]any{1, 2, 3}); !result.IsValid {
+		t.Errorf("Expected sorted array to be valid, got %+v", result.Errors)
+	}
+
+	result := validator.Validate([]any{1, 3, 2})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "[2]" {
+		t.Errorf("Expected error at '[2]', got %q", result.Errors[0].Field)
+	}
+
+	// Custom checks only see arrays whose items are all valid
+	result = validator.Validate([]any{1, "two"})
+	if len(result.Errors) != 1 || result.Errors[0].Code != CodeNumberType {
+		t.Errorf("Expected only the item error, got %+v", result.Errors)
+	}
+}
+
+func TestArrayValidator_Output(t *testing.T) {
+	validator := &ArrayValidator[[]string]{ItemValidator: (&StringValidator{}).Trim()}
+
+	// The output keeps the slice type when the items still fit it
+	result := validator.Validate([]string{" a ", "b "})
+	if !result.IsValid || !reflect.DeepEqual(result.Value, []string{"a", "b"}) {
+		t.Errorf("Expected trimmed []string output, got %#v", result.Value)
+	}
+
+	// Items transformed into another type fall back to []any
+	numbers := &ArrayValidator[[]any]{ItemValidator: (&NumberValidator{}).Coerce()}
+	result = numbers.Validate([]string{"1", "2"})
+	if !result.IsValid || !reflect.DeepEqual(result.Value, []any{json.Number("1"), json.Number("2")}) {
+		t.Errorf("Expected coerced []any output, got %#v", result.Value)
+	}
+}
+
+func TestArrayValidator_ItemCounts(t *testing.T) {
+	validator := (&ArrayValidator[[]any]{}).MinItems(1).MaxItems(3)
+
+	for _, value := range [][]any{{1}, {1, 2, 3}} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got %+v", value, result.Errors)
+		}
+	}
+	result := validator.Validate([]any{})
+	if result.IsValid || result.Errors[0].Code != CodeArrayMinItems || result.Errors[0].Params["min"] != 1 {
+		t.Errorf("Expected min items error, got %+v", result.Errors)
+	}
+	result = validator.Validate([]any{1, 2, 3, 4})
+	if result.IsValid || result.Errors[0].Code != CodeArrayMaxItems || result.Errors[0].Params["actual"] != 4 {
+		t.Errorf("Expected max items error, got %+v", result.Errors)
+	}
+
+	exact := (&ArrayValidator[[]any]{}).Length(2)
+	if result := exact.Validate([]any{1}); result.IsValid || result.Errors[0].Code != CodeArrayMinItems {
+		t.Errorf("Expected length error, got %+v", result.Errors)
+	}
+}
+
+func TestArrayValidator_Unique(t *testing.T) {
+	validator := (&ArrayValidator[[]any]{}).Unique()
+
+	if result := validator.Validate([]any{1, "1", map[string]any{"a": 1}}); !result.IsValid {
+		t.Errorf("Expected distinct items to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate([]any{1, 2, 1.0, map[string]any{"a": 1}, map[string]any{"a": 1.0}})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 duplicate errors, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "[2]" || result.Errors[0].Params["first"] != 0 || result.Errors[1].Field != "[4]" {
+		t.Errorf("Expected duplicates at '[2]' and '[4]', got %+v", result.Errors)
+	}
+
+	byID := (&ArrayValidator[[]any]{ItemValidator: &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"id":   &NumberValidator{},
+		"name": &StringValidator{},
+	}}}).UniqueBy(func(item any) any {
+		return item.(map[string]any)["id"]
+	})
+	result = byID.Validate([]any{
+		map[string]any{"id": 1, "name": "a"},
+		map[string]any{"id": 2, "name": "b"},
+		map[string]any{"id": 1, "name": "c"},
+	})
+	if result.IsValid || result.Errors[0].Code != CodeArrayUnique || result.Errors[0].Field != "[2]" {
+		t.Errorf("Expected duplicate id at '[2]', got %+v", result.Errors)
+	}
+	// Key functions only run on valid items
+	if result := byID.Validate([]any{"not an object"}); result.IsValid || result.Errors[0].Code != CodeObjectType {
+		t.Errorf("Expected item type error, got %+v", result.Errors)
+	}
+}
+
+func TestArrayValidator_UniqueNonJSONValues(t *testing.T) {
+	type secret struct{ value string }
+	type point struct{ X, Y int }
+	validator := (&ArrayValidator[[]any]{}).Unique()
+
+	// Structs with only unexported fields, and []byte next to the base64
+	// string it encodes to, all look alike as JSON
+	distinct := [][]any{
+		{secret{"a"}, secret{"b"}},
+		{[]byte("hi"), "aGk="},
+		{point{1, 2}, map[string]any{"X": 1, "Y": 2}},
+		{json.Number("1.0"), json.Number("1.5")},
+	}
+	for _, items := range distinct {
+		if result := validator.Validate(items); !result.IsValid {
+			t.Errorf("%v: expected distinct items to be valid, got %+v", items, result.Errors)
+		}
+	}
+
+	duplicates := [][]any{
+		{secret{"a"}, secret{"a"}},
+		{[]byte("hi"), []byte("hi")},
+		{point{1, 2}, point{1, 2}},
+		{json.Number("1.0"), 1},
+		{json.Number("9007199254740993"), int64(9007199254740993)},
+	}
+	for _, items := range duplicates {
+		result := validator.Validate(items)
+		if result.IsValid || result.Errors[0].Field != "[1]" || result.Errors[0].Params["first"] != 0 {
+			t.Errorf("%v: expected a duplicate at '[1]', got %+v", items, result.Errors)
+		}
+	}
+
+	if result := validator.Validate([]any{json.Number("9007199254740993"), int64(9007199254740992)}); !result.IsValid {
+		t.Errorf("Expected large integers to compare exactly, got %+v", result.Errors)
+	}
+}
+
+func TestArrayValidator_Contains(t *testing.T) {
+	admin := &EnumValidator{Values: []any{"admin"}}
+	validator := (&ArrayValidator[[]any]{ItemValidator: &StringValidator{}}).Contains(admin)
+
+	if result := validator.Validate([]any{"user", "admin"}); !result.IsValid {
+		t.Errorf("Expected roles with admin to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate([]any{"user"})
+	if result.IsValid || result.Errors[0].Code != CodeArrayContains || result.Errors[0].Params["actual"] != 0 {
+		t.Errorf("Expected contains error, got %+v", result.Errors)
+	}
+
+	validator = validator.MinContains(0).MaxContains(1)
+	if result := validator.Validate([]any{}); !result.IsValid {
+		t.Errorf("Expected MinContains(0) to accept no match, got %+v", result.Errors)
+	}
+	result = validator.Validate([]any{"admin", "admin"})
+	if result.IsValid || result.Errors[0].Code != CodeArrayMaxContains {
+		t.Errorf("Expected max contains error, got %+v", result.Errors)
+	}
+}
+
+func TestArrayValidator_Tuple(t *testing.T) {
+	point := (&ArrayValidator[[]any]{}).Tuple(
+		(&NumberValidator{}).Min(-90).Max(90),
+		(&NumberValidator{}).Min(-180).Max(180),
+		(&StringValidator{}).Optional(),
+	)
+
+	for _, value := range [][]any{{10, 20}, {10, 20, "home"}} {
+		if result := point.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got %+v", value, result.Errors)
+		}
+	}
+
+	result := point.Validate([]any{100})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "[0]" || result.Errors[0].Code != CodeNumberMax {
+		t.Errorf("Expected max error at '[0]', got %+v", result.Errors[0])
+	}
+	if result.Errors[1].Field != "[1]" || result.Errors[1].Code != CodeNumberRequired {
+		t.Errorf("Expected missing position at '[1]', got %+v", result.Errors[1])
+	}
+
+	result = point.Validate([]any{1, 2, "home", true})
+	if result.IsValid || result.Errors[0].Field != "[3]" || result.Errors[0].Code != CodeArrayExtraItem {
+		t.Errorf("Expected extra item error at '[3]', got %+v", result.Errors)
+	}
+
+	point = point.Rest(&BooleanValidator{})
+	if result := point.Validate([]any{1, 2, "home", true, false}); !result.IsValid {
+		t.Errorf("Expected rest items to be valid, got %+v", result.Errors)
+	}
+	if result := point.Validate([]any{1, 2, "home", "x"}); result.IsValid || result.Errors[0].Field != "[3]" {
+		t.Errorf("Expected rest error at '[3]', got %+v", result.Errors)
+	}
+}
@@ -1,19 +1,43 @@
 package validation
 
-// BaseValidator provides common functionality for all validators
+import "context"
+
+// BaseValidator provides common functionality for all validators.
+//
+// Every validator also has ValidateContext, RefineContext, Transform and
+// Default. ValidateContext validates like Validate but passes ctx to the
+// RefineContext checks anywhere in the tree, e.g. to look a value up in a
+// database, and validates object fields and array items concurrently (see
+// Options). It stops with a canceled error once ctx is canceled or its
+// deadline expires. Transforms run in order after every check passed, and
+// an error from one rejects the value. Default sets the value used when the
+// input is missing, which makes the validator optional
 type BaseValidator struct {
-	optional bool
-	message  string
+	optional     bool
+	message      string
+	abortEarly   bool
+	refinements  []func(ctx context.Context, value any) *ValidationError
+	transforms   []func(value any) (any, error)
+	defaultValue any
+	hasDefault   bool
 }
 
 func (b *BaseValidator) setOptional() {
 	b.optional = true
 }
 
+func (b *BaseValidator) setOptionalTo(optional bool) {
+	b.optional = optional
+}
+
 func (b *BaseValidator) setMessage(message string) {
 	b.message = message
 }
 
+func (b *BaseValidator) setAbortEarly(abortEarly bool) {
+	b.abortEarly = abortEarly
+}
+
 func (b *BaseValidator) getMessage(defaultMsg string) string {
 	if b.message != "" {
 		return b.message
@@ -21,6 +45,109 @@ func (b *BaseValidator) getMessage(defaultMsg string) string {
 	return defaultMsg
 }
 
+// newError builds a ValidationError with the given code and params. The
+// message is the custom message when one is set, otherwise the template for
+// the code in the requested locale; either may reference params as {name}
+func (b *BaseValidator) newError(opts Options, code string, params map[string]any) ValidationError {
+	return ValidationError{
+		Field:   "",
+		Message: formatMessage(b.getMessage(opts.template(code)), params),
+		Code:    code,
+		Params:  params,
+	}
+}
+
+// isOptional reports whether a missing value is accepted, which is the case
+// for validators with a default value too
 func (b *BaseValidator) isOptional() bool {
-	return b.optional
+	return b.optional || b.hasDefault
+}
+
+// IsOptional reports whether the validator accepts missing (nil) values
+func (b *BaseValidator) IsOptional() bool {
+	return b.isOptional()
+}
+
+// shouldAbort reports whether validation must stop because the validator
+// runs in abort-early mode and at least one error has been collected
+func (b *BaseValidator) shouldAbort(errors []ValidationError) bool {
+	return b.abortEarly && len(errors) > 0
+}
+
+func (b *BaseValidator) addRefinement(check func(value any) *ValidationError) {
+	b.addContextRefinement(func(_ context.Context, value any) *ValidationError {
+		return check(value)
+	})
+}
+
+func (b *BaseValidator) addContextRefinement(check func(ctx context.Context, value any) *ValidationError) {
+	b.refinements = appendCopy(b.refinements, check)
+}
+
+// refine runs the custom checks registered with Refine and RefineContext
+// against a value that passed the built-in type check, appending their
+// errors. It stops early like the built-in constraints when the validator
+// aborts early, and reports cancellation instead of running further checks
+// once the call's context is done
+func (b *BaseValidator) refine(value any, errors []ValidationError, opts Options) []ValidationError {
+	ctx := opts.ctx()
+	for _, check := range b.refinements {
+		if b.shouldAbort(errors) {
+			break
+		}
+		if ctx.Err() != nil {
+			errors = append(errors, canceledError(ctx, opts))
+			break
+		}
+		if err := check(ctx, value); err != nil {
+			errors = append(errors, b.refinementError(*err, opts))
+		}
+	}
+	return errors
+}
+
+// refined returns the outcome of the custom checks for a value that passed
+// every built-in check
+func (b *BaseValidator) refined(value any, opts Options) ValidationResult {
+	return b.finish(value, b.refine(value, nil, opts), opts)
+}
+
+// refinementError completes an error reported by a custom check: it defaults
+// the code to CodeCustom, fills in the message from the catalog when none is
+// given and turns a Field-only location into a path
+func (b *BaseValidator) refinementError(err ValidationError, opts Options) ValidationError {
+	if err.Code == "" {
+		err.Code = CodeCustom
+	}
+	if err.Message == "" {
+		err.Message = formatMessage(b.getMessage(opts.template(err.Code)), err.Params)
+	}
+	if len(err.Path) == 0 && err.Field != "" {
+		err.Path = Path{Key(err.Field)}
+	}
+	err.Field = err.Path.String()
+	return err
+}
+
+// clone returns a shallow copy of a validator. Builder methods modify and
+// return a clone, so that a validator never changes once it is built and
+// may be shared between schemas and goroutines
+func clone[V any](validator *V) *V {
+	copied := *validator
+	return &copied
+}
+
+// appendCopy appends to values without writing to its backing array, which
+// clones of a validator may share
+func appendCopy[E any](values []E, elems ...E) []E {
+	return append(values[:len(values):len(values)], elems...)
+}
+
+// copyPointer returns a pointer to a copy of the value, or nil
+func copyPointer[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
 }
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 )
@@ -8,9 +9,24 @@ import (
 // BooleanValidator validates boolean values
 type BooleanValidator struct {
 	BaseValidator
+	coerce bool
 }
 
 func (b *BooleanValidator) Validate(value any) ValidationResult {
+	return b.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (b *BooleanValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return b.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (b *BooleanValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = b.applyDefault(value)
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if b.isOptional() {
@@ -19,37 +35,81 @@ func (b *BooleanValidator) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: b.getMessage("Boolean value is required"),
-				},
+				b.newError(opts, CodeBooleanRequired, nil),
 			},
 		}
 	}
 
+	// Parse boolean strings when coercion is enabled
+	if b.coerce {
+		value = coerceBoolean(value)
+	}
+
 	// Check if the value is actually a boolean
 	if reflect.TypeOf(value).Kind() != reflect.Bool {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: b.getMessage(fmt.Sprintf("Expected boolean value, got %T", value)),
-				},
+				b.newError(opts, CodeBooleanType,
+					map[string]any{"expected": "boolean", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
 
-	// Value is a valid boolean
-	return ValidationResult{IsValid: true, Errors: nil}
+	// Value is a valid boolean; run custom checks
+	return b.refined(value, opts)
+}
+
+// Refine adds a custom check that receives the boolean once it passed the
+// type check and returns an error, or nil when the value is acceptable
+func (b *BooleanValidator) Refine(check func(value any) *ValidationError) *BooleanValidator {
+	b = clone(b)
+	b.addRefinement(check)
+	return b
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (b *BooleanValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *BooleanValidator {
+	b = clone(b)
+	b.addContextRefinement(check)
+	return b
+}
+
+// Coerce parses strings such as "true", "false", "1" or "0" into a bool
+// before validation
+func (b *BooleanValidator) Coerce() *BooleanValidator {
+	b = clone(b)
+	b.coerce = true
+	return b
+}
+
+// Transform adds a function that turns the valid value into the output
+func (b *BooleanValidator) Transform(transform func(value any) (any, error)) *BooleanValidator {
+	b = clone(b)
+	b.addTransform(transform)
+	return b
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (b *BooleanValidator) Default(value bool) *BooleanValidator {
+	b = clone(b)
+	b.setDefault(value)
+	return b
 }
 
 func (b *BooleanValidator) Optional() Validator[bool] {
+	b = clone(b)
 	b.setOptional()
 	return b
 }
 
+// Nullable also accepts null, see NullableValidator
+func (b *BooleanValidator) Nullable() *NullableValidator {
+	return nullableOf(b, b.optional)
+}
+
 func (b *BooleanValidator) WithMessage(message string) Validator[bool] {
+	b = clone(b)
 	b.setMessage(message)
 	return b
 }
@@ -72,7 +72,7 @@ func TestBooleanValidator_ValidateNilOptional(t *testing.T) {
 	validator := &BooleanValidator{}
 
 	// Test with nil value (optional)
-	validator.Optional()
+	validator = validator.Optional().(*BooleanValidator)
 	result := validator.Validate(nil)
 	if !result.IsValid {
 		t.Error("Boolean validator should accept nil value when optional")
@@ -87,13 +87,17 @@ func TestBooleanValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
+	}
+	copied := result.(*BooleanValidator)
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -104,13 +108,17 @@ func TestBooleanValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
 	}
+	copied := result.(*BooleanValidator)
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -119,7 +127,7 @@ func TestBooleanValidator_WithMessageValidation(t *testing.T) {
 	validator := &BooleanValidator{}
 	customMessage := "Custom boolean validation message"
 
-	validator.WithMessage(customMessage)
+	validator = validator.WithMessage(customMessage).(*BooleanValidator)
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate("not a boolean")
@@ -142,16 +150,20 @@ func TestBooleanValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
 	}
+	copied := result.(*BooleanValidator)
 
 	// Should have all properties set
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -207,3 +219,16 @@ func TestBooleanValidator_ErrorMessages(t *testing.T) {
 		t.Errorf("Expected type error message '%s', got '%s'", expectedTypeMsg, result.Errors[0].Message)
 	}
 }
+
+func TestBooleanValidator_CoerceAndDefault(t *testing.T) {
+	validator := (&BooleanValidator{}).Coerce().Default(true)
+
+	for input, expected := range map[any]bool{"true": true, "0": false, " FALSE ": false, nil: true} {
+		if result := validator.Validate(input); !result.IsValid || result.Value != expected {
+			t.Errorf("Expected %v to produce %v, got %+v", input, expected, result)
+		}
+	}
+	if result := validator.Validate("yes"); result.IsValid {
+		t.Error("Boolean validator should reject strings it cannot parse")
+	}
+}
@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"context"
+	"sync"
+)
+
+// validationJob validates one child of a composite value, e.g. an object
+// field or an array item
+type validationJob func(opts Options) ValidationResult
+
+// validateAll runs the jobs and returns their results in job order. On the
+// synchronous path (no context in opts) the jobs run one after another and
+// stop at the first failure in abort-early mode; with a context they run
+// concurrently, so slow RefineContext checks of siblings overlap. The whole
+// call shares Options.Concurrency goroutines; a job that finds none free runs
+// in the current goroutine, so nested jobs never wait for their parents'
+// slots. Either way the results are cut off after the first job that observed
+// cancellation
+func (b *BaseValidator) validateAll(jobs []validationJob, opts Options) []ValidationResult {
+	ctx := opts.ctx()
+	results := make([]ValidationResult, 0, len(jobs))
+
+	if opts.Context == nil || len(jobs) < 2 {
+		for _, job := range jobs {
+			result := runJob(ctx, job, opts)
+			results = append(results, result)
+			if isCanceled(result) || (b.abortEarly && !result.IsValid) {
+				break
+			}
+		}
+		return results
+	}
+
+	if opts.slots == nil {
+		limit := opts.Concurrency
+		if limit <= 0 {
+			limit = DefaultConcurrency
+		}
+		opts.slots = make(chan struct{}, limit)
+	}
+
+	results = results[:len(jobs)]
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		select {
+		case opts.slots <- struct{}{}:
+			wg.Add(1)
+			go func(i int, job validationJob) {
+				defer wg.Done()
+				defer func() { <-opts.slots }()
+				results[i] = runJob(ctx, job, opts)
+			}(i, job)
+		default:
+			results[i] = runJob(ctx, job, opts)
+		}
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		if isCanceled(result) {
+			return results[:i+1]
+		}
+	}
+	return results
+}
+
+// runJob runs a single job unless the context is already done
+func runJob(ctx context.Context, job validationJob, opts Options) ValidationResult {
+	if ctx.Err() != nil {
+		return ValidationResult{IsValid: false, Errors: []ValidationError{canceledError(ctx, opts)}}
+	}
+	return job(opts)
+}
+
+// canceledError reports why the context ended. Custom validator messages do
+// not apply, as cancellation says nothing about the value itself
+func canceledError(ctx context.Context, opts Options) ValidationError {
+	params := map[string]any{"reason": ctx.Err().Error()}
+	return ValidationError{
+		Message: formatMessage(opts.template(CodeCanceled), params),
+		Code:    CodeCanceled,
+		Params:  params,
+	}
+}
+
+// isCanceled reports whether the result contains a cancellation error
+func isCanceled(result ValidationResult) bool {
+	for _, err := range result.Errors {
+		if err.Code == CodeCanceled {
+			return true
+		}
+	}
+	return false
+}
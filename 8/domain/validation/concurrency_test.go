@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// barrierCheck returns a RefineContext check that only passes once n checks
+// are running at the same time, so it blocks forever when run sequentially
+func barrierCheck(n int) func(ctx context.Context, value any) *ValidationError {
+	var wg sync.WaitGroup
+	wg.Add(n)
+	released := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(released)
+	}()
+	return func(ctx context.Context, value any) *ValidationError {
+		wg.Done()
+		select {
+		case <-released:
+			return nil
+		case <-ctx.Done():
+			return &ValidationError{Message: "checks did not overlap"}
+		}
+	}
+}
+
+func TestValidateContext_ObjectFieldsRunConcurrently(t *testing.T) {
+	check := barrierCheck(2)
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"username": (&StringValidator{}).RefineContext(check),
+		"sku":      (&StringValidator{}).RefineContext(check),
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result := validator.ValidateContext(ctx, map[string]any{"username": "john", "sku": "A-1"})
+	if !result.IsValid {
+		t.Errorf("Expected sibling checks to run concurrently, got %+v", result.Errors)
+	}
+}
+
+func TestValidateContext_ArrayItemsRunConcurrently(t *testing.T) {
+	check := barrierCheck(3)
+	validator := &ArrayValidator[[]any]{ItemValidator: (&NumberValidator{}).RefineContext(
+		func(ctx context.Context, value any) *ValidationError {
+			if err := check(ctx, value); err != nil {
+				return err
+			}
+			if value.(int)%2 == 0 {
+				return &ValidationError{Message: "Item must be odd"}
+			}
+			return nil
+		})}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result := validator.ValidateContext(ctx, []any{2, 3, 4})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	// Errors keep the item order regardless of which check finished first
+	if result.Errors[0].Field != "[0]" || result.Errors[1].Field != "[2]" {
+		t.Errorf("Expected errors at '[0]' and '[2]', got %+v", result.Errors)
+	}
+}
+
+func TestValidateContext_Canceled(t *testing.T) {
+	calls := 0
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"username": (&StringValidator{}).RefineContext(func(ctx context.Context, value any) *ValidationError {
+			calls++
+			return nil
+		}),
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := validator.ValidateContext(ctx, map[string]any{"username": "john"})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error for canceled context, got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeCanceled || result.Errors[0].Field != "username" {
+		t.Errorf("Expected canceled error at 'username', got %+v", result.Errors[0])
+	}
+	if calls != 0 {
+		t.Errorf("Expected no check to run after cancellation, got %d calls", calls)
+	}
+}
+
+func TestValidateContext_Deadline(t *testing.T) {
+	validator := (&StringValidator{}).RefineContext(func(ctx context.Context, value any) *ValidationError {
+		<-ctx.Done()
+		return nil
+	}).RefineContext(func(ctx context.Context, value any) *ValidationError {
+		t.Error("Checks after the deadline should not run")
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	result := validator.ValidateContext(ctx, "john")
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Code != CodeCanceled {
+		t.Fatalf("Expected canceled error, got %+v", result.Errors)
+	}
+	if reason := result.Errors[0].Params["reason"]; reason != context.DeadlineExceeded.Error() {
+		t.Errorf("Expected deadline reason, got %v", reason)
+	}
+}
+
+func TestValidateContext_SuperRefineContext(t *testing.T) {
+	errTaken := errors.New("taken")
+	lookup := func(ctx context.Context, username string) error {
+		if username == "admin" {
+			return errTaken
+		}
+		return ctx.Err()
+	}
+	validator := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"username": &StringValidator{},
+	}}).SuperRefineContext(func(ctx context.Context, value map[string]any) []ValidationError {
+		if err := lookup(ctx, value["username"].(string)); err != nil {
+			return []ValidationError{{Field: "username", Code: "username_taken", Message: "Username is already taken"}}
+		}
+		return nil
+	})
+
+	if result := validator.ValidateContext(context.Background(), map[string]any{"username": "john"}); !result.IsValid {
+		t.Errorf("Expected free username to be valid, got %+v", result.Errors)
+	}
+	result := validator.ValidateContext(context.Background(), map[string]any{"username": "admin"})
+	if result.IsValid || result.Errors[0].Code != "username_taken" || result.Errors[0].Field != "username" {
+		t.Errorf("Expected taken error at 'username', got %+v", result.Errors)
+	}
+}
+
+func TestValidateContext_MatchesSynchronousPath(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name": (&StringValidator{}).MinLength(3),
+		"tags": (&ArrayValidator[[]any]{ItemValidator: &StringValidator{}}).AbortEarly(),
+	}}
+	value := map[string]any{"name": "Jo", "tags": []any{"a", 1, 2}}
+
+	syncResult := validator.Validate(value)
+	withContext := validator.ValidateContext(context.Background(), value)
+	if len(syncResult.Errors) != 2 || len(withContext.Errors) != 2 {
+		t.Fatalf("Expected 2 errors on both paths, got %+v and %+v", syncResult.Errors, withContext.Errors)
+	}
+
+	validator = validator.AbortEarly()
+	if result := validator.ValidateContext(context.Background(), value); len(result.Errors) != 1 {
+		t.Errorf("Expected 1 error in abort-early mode, got %+v", result.Errors)
+	}
+}
+
+func TestValidateContext_BoundedGoroutines(t *testing.T) {
+	var mu sync.Mutex
+	peak := 0
+	item := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"id": &NumberValidator{},
+		"tags": &ArrayValidator[any]{ItemValidator: (&StringValidator{}).RefineContext(
+			func(ctx context.Context, value any) *ValidationError {
+				mu.Lock()
+				if running := runtime.NumGoroutine(); running > peak {
+					peak = running
+				}
+				mu.Unlock()
+				return nil
+			})},
+	}})
+	validator := &ArrayValidator[any]{ItemValidator: item}
+
+	items := make([]any, 2000)
+	for i := range items {
+		items[i] = map[string]any{"id": i, "tags": []any{"a", "b", "c"}}
+	}
+
+	baseline := runtime.NumGoroutine()
+	result := validator.ValidateWithOptions(items, Options{Context: context.Background(), Concurrency: 4})
+	if !result.IsValid {
+		t.Fatalf("Expected valid items, got %d errors", len(result.Errors))
+	}
+	if peak > baseline+4 {
+		t.Errorf("Expected at most 4 extra goroutines, peaked at %d over a baseline of %d", peak, baseline)
+	}
+}
+
+func TestValidateContext_ConcurrencyOfOne(t *testing.T) {
+	// One goroutine plus the caller still lets two checks overlap
+	check := barrierCheck(2)
+	validator := &ArrayValidator[any]{ItemValidator: (&StringValidator{}).RefineContext(check)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result := validator.ValidateWithOptions([]any{"a", "b"}, Options{Context: ctx, Concurrency: 1})
+	if !result.IsValid {
+		t.Errorf("Expected checks to overlap with a limit of one, got %+v", result.Errors)
+	}
+}
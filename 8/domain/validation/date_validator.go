@@ -1,17 +1,88 @@
 package validation
 
 import (
+	"context"
 	"fmt"
-	"reflect"
+	"math/big"
+	"strings"
 	"time"
 )
 
-// DateValidator validates date/time values
+// DefaultDateLayouts are the layouts Coerce parses strings with when no
+// layouts were configured
+var DefaultDateLayouts = []string{time.RFC3339Nano, time.DateOnly}
+
+// DateValidator validates date/time values. Besides time.Time values it can
+// parse strings with configurable layouts and Unix epoch numbers, so JSON
+// payloads can be validated without decoding them first
 type DateValidator struct {
 	BaseValidator
+	coerce      bool
+	layouts     []string
+	epochUnit   time.Duration
+	min         *time.Time
+	max         *time.Time
+	after       *time.Time
+	before      *time.Time
+	past        bool
+	future      bool
+	clock       func() time.Time
+	weekdays    []time.Weekday
+	businessDay bool
+	holidays    []time.Time
+}
+
+// DateConstraints describes the constraints configured on a DateValidator
+type DateConstraints struct {
+	// Layouts are the layouts strings are parsed with, none when only
+	// time.Time values are accepted
+	Layouts []string
+	// EpochUnit is time.Second or time.Millisecond when numbers are read
+	// as Unix epochs, zero otherwise
+	EpochUnit   time.Duration
+	Min         *time.Time
+	Max         *time.Time
+	After       *time.Time
+	Before      *time.Time
+	Past        bool
+	Future      bool
+	Weekdays    []time.Weekday
+	BusinessDay bool
+	Holidays    []time.Time
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (d *DateValidator) Constraints() DateConstraints {
+	return DateConstraints{
+		Layouts:     append([]string(nil), d.parseLayouts()...),
+		EpochUnit:   d.epochUnit,
+		Min:         copyPointer(d.min),
+		Max:         copyPointer(d.max),
+		After:       copyPointer(d.after),
+		Before:      copyPointer(d.before),
+		Past:        d.past,
+		Future:      d.future,
+		Weekdays:    append([]time.Weekday(nil), d.weekdays...),
+		BusinessDay: d.businessDay,
+		Holidays:    append([]time.Time(nil), d.holidays...),
+	}
 }
 
 func (d *DateValidator) Validate(value any) ValidationResult {
+	return d.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (d *DateValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return d.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (d *DateValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = d.applyDefault(value)
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if d.isOptional() {
@@ -20,37 +91,354 @@ func (d *DateValidator) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: d.getMessage("Date value is required"),
-				},
+				d.newError(opts, CodeDateRequired, nil),
 			},
 		}
 	}
 
+	// Parse strings and epoch numbers when enabled
+	if layouts := d.parseLayouts(); len(layouts) > 0 {
+		if str, ok := value.(string); ok {
+			parsed, ok := parseDate(str, layouts)
+			if !ok {
+				return ValidationResult{
+					IsValid: false,
+					Errors: []ValidationError{
+						d.newError(opts, CodeDateFormat,
+							map[string]any{"layouts": strings.Join(layouts, ", "), "actual": str}),
+					},
+				}
+			}
+			value = parsed
+		}
+	}
+	if d.epochUnit != 0 {
+		if epoch, ok := toNumber(value); ok {
+			parsed, ok := epochTime(epoch, d.epochUnit)
+			if !ok {
+				return ValidationResult{
+					IsValid: false,
+					Errors: []ValidationError{
+						d.newError(opts, CodeDateFormat,
+							map[string]any{"layouts": epochLayout(d.epochUnit), "actual": fmt.Sprint(value)}),
+					},
+				}
+			}
+			value = parsed
+		}
+	}
+
 	// Check if the value is actually a time.Time
-	if reflect.TypeOf(value) != reflect.TypeOf(time.Time{}) {
+	date, ok := value.(time.Time)
+	if !ok {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: d.getMessage(fmt.Sprintf("Expected time.Time value, got %T", value)),
-				},
+				d.newError(opts, CodeDateType,
+					map[string]any{"expected": "date", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
 
-	// Value is a valid time.Time
-	return ValidationResult{IsValid: true, Errors: nil}
+	// check records a failed constraint and reports whether to stop
+	var errors []ValidationError
+	check := func(failed bool, code string, params map[string]any) bool {
+		if failed {
+			errors = append(errors, d.newError(opts, code, params))
+		}
+		return d.shouldAbort(errors)
+	}
+
+	// Check the bounds; Min and Max are inclusive, After and Before are not
+	if d.min != nil && check(date.Before(*d.min), CodeDateMin, map[string]any{"min": formatDate(*d.min)}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	if d.max != nil && check(date.After(*d.max), CodeDateMax, map[string]any{"max": formatDate(*d.max)}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	if d.after != nil && check(!date.After(*d.after), CodeDateAfter, map[string]any{"after": formatDate(*d.after)}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	if d.before != nil && check(!date.Before(*d.before), CodeDateBefore, map[string]any{"before": formatDate(*d.before)}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+
+	// Check the value against the clock
+	if d.past || d.future {
+		now := d.now()
+		if d.past && check(!date.Before(now), CodeDatePast, map[string]any{"now": formatDate(now)}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if d.future && check(!date.After(now), CodeDateFuture, map[string]any{"now": formatDate(now)}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+	}
+
+	// Check the day of the week in the value's own location
+	if len(d.weekdays) > 0 && check(!containsWeekday(d.weekdays, date.Weekday()), CodeDateWeekday,
+		map[string]any{"allowed": joinWeekdays(d.weekdays), "actual": date.Weekday().String()}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	if d.businessDay && check(!d.isBusinessDay(date), CodeDateBusinessDay,
+		map[string]any{"actual": date.Format(time.DateOnly)}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+
+	// Run custom checks, then transforms
+	errors = d.refine(date, errors, opts)
+	return d.finish(date, errors, opts)
+}
+
+// Min requires the date to be at or after t
+func (d *DateValidator) Min(t time.Time) *DateValidator {
+	d = clone(d)
+	d.min = &t
+	return d
+}
+
+// Max requires the date to be at or before t
+func (d *DateValidator) Max(t time.Time) *DateValidator {
+	d = clone(d)
+	d.max = &t
+	return d
+}
+
+// After requires the date to be strictly after t
+func (d *DateValidator) After(t time.Time) *DateValidator {
+	d = clone(d)
+	d.after = &t
+	return d
+}
+
+// Before requires the date to be strictly before t
+func (d *DateValidator) Before(t time.Time) *DateValidator {
+	d = clone(d)
+	d.before = &t
+	return d
+}
+
+// Past requires the date to be before the current time of the clock
+func (d *DateValidator) Past() *DateValidator {
+	d = clone(d)
+	d.past = true
+	return d
+}
+
+// Future requires the date to be after the current time of the clock
+func (d *DateValidator) Future() *DateValidator {
+	d = clone(d)
+	d.future = true
+	return d
+}
+
+// Clock replaces time.Now as the source of the current time for Past and
+// Future, e.g. to make tests deterministic
+func (d *DateValidator) Clock(now func() time.Time) *DateValidator {
+	d = clone(d)
+	d.clock = now
+	return d
+}
+
+// Weekdays only accepts dates falling on one of the given days of the week
+func (d *DateValidator) Weekdays(days ...time.Weekday) *DateValidator {
+	d = clone(d)
+	d.weekdays = append([]time.Weekday(nil), days...)
+	return d
+}
+
+// BusinessDay only accepts dates from Monday to Friday that are not one of
+// the given holidays. Holidays match on the calendar date alone
+func (d *DateValidator) BusinessDay(holidays ...time.Time) *DateValidator {
+	d = clone(d)
+	d.businessDay = true
+	d.holidays = append([]time.Time(nil), holidays...)
+	return d
+}
+
+// Layouts accepts strings parsed with the given time layouts, tried in
+// order, e.g. time.RFC3339 or "2006-01-02"
+func (d *DateValidator) Layouts(layouts ...string) *DateValidator {
+	d = clone(d)
+	d.layouts = append([]string(nil), layouts...)
+	return d
+}
+
+// Unix accepts numbers as seconds since the Unix epoch
+func (d *DateValidator) Unix() *DateValidator {
+	d = clone(d)
+	d.epochUnit = time.Second
+	return d
+}
+
+// UnixMilli accepts numbers as milliseconds since the Unix epoch
+func (d *DateValidator) UnixMilli() *DateValidator {
+	d = clone(d)
+	d.epochUnit = time.Millisecond
+	return d
+}
+
+// Refine adds a custom check that receives the time.Time once it passed the
+// type check and returns an error, or nil when the value is acceptable
+func (d *DateValidator) Refine(check func(value any) *ValidationError) *DateValidator {
+	d = clone(d)
+	d.addRefinement(check)
+	return d
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (d *DateValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *DateValidator {
+	d = clone(d)
+	d.addContextRefinement(check)
+	return d
+}
+
+// Coerce parses strings into a time.Time before validation, using
+// DefaultDateLayouts (RFC 3339 timestamps and 2006-01-02 dates) unless
+// Layouts configured others
+func (d *DateValidator) Coerce() *DateValidator {
+	d = clone(d)
+	d.coerce = true
+	return d
+}
+
+// Transform adds a function that turns the valid value into the output
+func (d *DateValidator) Transform(transform func(value any) (any, error)) *DateValidator {
+	d = clone(d)
+	d.addTransform(transform)
+	return d
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (d *DateValidator) Default(value time.Time) *DateValidator {
+	d = clone(d)
+	d.setDefault(value)
+	return d
+}
+
+// AbortEarly stops validation at the first failed constraint
+func (d *DateValidator) AbortEarly() *DateValidator {
+	d = clone(d)
+	d.setAbortEarly(true)
+	return d
+}
+
+// CollectAll reports every failed constraint (the default)
+func (d *DateValidator) CollectAll() *DateValidator {
+	d = clone(d)
+	d.setAbortEarly(false)
+	return d
 }
 
 func (d *DateValidator) Optional() Validator[time.Time] {
+	d = clone(d)
 	d.setOptional()
 	return d
 }
 
+// Nullable also accepts null, see NullableValidator
+func (d *DateValidator) Nullable() *NullableValidator {
+	return nullableOf(d, d.optional)
+}
+
 func (d *DateValidator) WithMessage(message string) Validator[time.Time] {
+	d = clone(d)
 	d.setMessage(message)
 	return d
 }
+
+// parseLayouts returns the layouts strings are parsed with, none when string
+// parsing is disabled
+func (d *DateValidator) parseLayouts() []string {
+	if len(d.layouts) > 0 {
+		return d.layouts
+	}
+	if d.coerce {
+		return DefaultDateLayouts
+	}
+	return nil
+}
+
+func (d *DateValidator) now() time.Time {
+	if d.clock != nil {
+		return d.clock()
+	}
+	return time.Now()
+}
+
+func (d *DateValidator) isBusinessDay(date time.Time) bool {
+	if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+	for _, holiday := range d.holidays {
+		if holiday.Year() == date.Year() && holiday.YearDay() == date.YearDay() {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDate parses the string with the first layout that accepts it
+func parseDate(value string, layouts []string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// formatDate renders a bound for error params and messages
+// minEpoch and maxEpoch bound the epochs accepted by Unix and UnixMilli to
+// the years 1 to 9999, which RFC 3339 and JSON can represent
+var (
+	minEpoch = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxEpoch = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+// epochTime converts an epoch in the given unit exactly, so that
+// json.Number("1700000000.000000001") keeps its nanosecond. It reports
+// false for NaN, infinities and epochs outside minEpoch..maxEpoch
+func epochTime(epoch number, unit time.Duration) (time.Time, bool) {
+	if !epoch.isFinite() {
+		return time.Time{}, false
+	}
+	nanos := new(big.Rat).Mul(epoch.rat, new(big.Rat).SetInt64(int64(unit)))
+	// Div rounds towards negative infinity, so times before 1970 keep a
+	// non-negative nanosecond part
+	whole := new(big.Int).Div(nanos.Num(), nanos.Denom())
+	seconds, nanoseconds := new(big.Int).DivMod(whole, big.NewInt(int64(time.Second)), new(big.Int))
+	if !seconds.IsInt64() || seconds.Int64() < minEpoch || seconds.Int64() > maxEpoch {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds.Int64(), nanoseconds.Int64()).UTC(), true
+}
+
+// epochLayout names an epoch unit in format errors
+func epochLayout(unit time.Duration) string {
+	if unit == time.Millisecond {
+		return "Unix milliseconds"
+	}
+	return "Unix seconds"
+}
+
+func formatDate(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, allowed := range days {
+		if allowed == day {
+			return true
+		}
+	}
+	return false
+}
+
+func joinWeekdays(days []time.Weekday) string {
+	names := make([]string, len(days))
+	for i, day := range days {
+		names[i] = day.String()
+	}
+	return strings.Join(names, ", ")
+}
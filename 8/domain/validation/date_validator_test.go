@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 )
@@ -82,7 +84,7 @@ func TestDateValidator_ValidateNilOptional(t *testing.T) {
 	validator := &DateValidator{}
 
 	// Test with nil value (optional)
-	validator.Optional()
+	validator = validator.Optional().(*DateValidator)
 	result := validator.Validate(nil)
 	if !result.IsValid {
 		t.Error("Date validator should accept nil value when optional")
@@ -97,13 +99,17 @@ func TestDateValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
+	}
+	copied := result.(*DateValidator)
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -114,13 +120,17 @@ func TestDateValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
 	}
+	copied := result.(*DateValidator)
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -129,7 +139,7 @@ func TestDateValidator_WithMessageValidation(t *testing.T) {
 	validator := &DateValidator{}
 	customMessage := "Custom date validation message"
 
-	validator.WithMessage(customMessage)
+	validator = validator.WithMessage(customMessage).(*DateValidator)
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate("not a date")
@@ -152,16 +162,20 @@ func TestDateValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
+	}
+	copied := result.(*DateValidator)
 
 	// Should have all properties set
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -265,3 +279,160 @@ func TestDateValidator_DifferentTimeFormats(t *testing.T) {
 		}
 	}
 }
+
+func TestDateValidator_Coerce(t *testing.T) {
+	validator := (&DateValidator{}).Coerce()
+
+	result := validator.Validate("2024-03-01T10:30:00Z")
+	expected := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+	if !result.IsValid || !expected.Equal(result.Value.(time.Time)) {
+		t.Errorf("Expected RFC 3339 string to coerce, got %+v", result)
+	}
+	result = validator.Validate("2024-03-01")
+	if !result.IsValid || !time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Equal(result.Value.(time.Time)) {
+		t.Errorf("Expected date string to coerce, got %+v", result)
+	}
+	if result := validator.Validate("01/03/2024"); result.IsValid || result.Errors[0].Code != CodeDateFormat {
+		t.Errorf("Expected format error for unknown layout, got %+v", result.Errors)
+	}
+}
+
+func TestDateValidator_Bounds(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	inclusive := (&DateValidator{}).Min(start).Max(end)
+	for _, date := range []time.Time{start, end, start.AddDate(0, 6, 0)} {
+		if result := inclusive.Validate(date); !result.IsValid {
+			t.Errorf("Expected %v to be within [Min, Max], got %+v", date, result.Errors)
+		}
+	}
+	if result := inclusive.Validate(start.Add(-time.Second)); result.IsValid || result.Errors[0].Code != CodeDateMin {
+		t.Errorf("Expected min error, got %+v", result.Errors)
+	}
+	if result := inclusive.Validate(end.Add(time.Second)); result.IsValid || result.Errors[0].Code != CodeDateMax {
+		t.Errorf("Expected max error, got %+v", result.Errors)
+	}
+
+	exclusive := (&DateValidator{}).After(start).Before(end)
+	result := exclusive.Validate(start)
+	if result.IsValid || result.Errors[0].Code != CodeDateAfter {
+		t.Errorf("Expected after error for the bound itself, got %+v", result.Errors)
+	}
+	if result.Errors[0].Message != "Date must be after 2024-01-01T00:00:00Z" {
+		t.Errorf("Unexpected message: %q", result.Errors[0].Message)
+	}
+	if result := exclusive.Validate(end); result.IsValid || result.Errors[0].Code != CodeDateBefore {
+		t.Errorf("Expected before error for the bound itself, got %+v", result.Errors)
+	}
+}
+
+func TestDateValidator_PastAndFuture(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	past := (&DateValidator{}).Past().Clock(clock)
+	if result := past.Validate(now.Add(-time.Hour)); !result.IsValid {
+		t.Errorf("Expected earlier date to be in the past, got %+v", result.Errors)
+	}
+	if result := past.Validate(now); result.IsValid || result.Errors[0].Code != CodeDatePast {
+		t.Errorf("Expected past error for the current time, got %+v", result.Errors)
+	}
+
+	future := (&DateValidator{}).Future().Clock(clock)
+	if result := future.Validate(now.Add(time.Hour)); !result.IsValid {
+		t.Errorf("Expected later date to be in the future, got %+v", result.Errors)
+	}
+	if result := future.Validate(now.Add(-time.Hour)); result.IsValid || result.Errors[0].Code != CodeDateFuture {
+		t.Errorf("Expected future error, got %+v", result.Errors)
+	}
+}
+
+func TestDateValidator_Weekdays(t *testing.T) {
+	saturday := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	monday := saturday.AddDate(0, 0, 2)
+
+	weekend := (&DateValidator{}).Weekdays(time.Saturday, time.Sunday)
+	if result := weekend.Validate(saturday); !result.IsValid {
+		t.Errorf("Expected Saturday to be allowed, got %+v", result.Errors)
+	}
+	result := weekend.Validate(monday)
+	if result.IsValid || result.Errors[0].Message != "Date must fall on Saturday, Sunday, got Monday" {
+		t.Errorf("Expected weekday error, got %+v", result.Errors)
+	}
+
+	holiday := monday.AddDate(0, 0, 1)
+	businessDay := (&DateValidator{}).BusinessDay(holiday)
+	if result := businessDay.Validate(monday); !result.IsValid {
+		t.Errorf("Expected Monday to be a business day, got %+v", result.Errors)
+	}
+	for _, date := range []time.Time{saturday, holiday.Add(15 * time.Hour)} {
+		if result := businessDay.Validate(date); result.IsValid || result.Errors[0].Code != CodeDateBusinessDay {
+			t.Errorf("Expected business day error for %v, got %+v", date, result.Errors)
+		}
+	}
+}
+
+func TestDateValidator_Parsing(t *testing.T) {
+	validator := (&DateValidator{}).Layouts("02/01/2006", time.DateOnly).UnixMilli()
+	expected := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, input := range []any{"01/03/2024", "2024-03-01", float64(expected.UnixMilli()), expected.UnixMilli()} {
+		result := validator.Validate(input)
+		if !result.IsValid || !expected.Equal(result.Value.(time.Time)) {
+			t.Errorf("Expected %v to parse as %v, got %+v", input, expected, result)
+		}
+	}
+
+	result := validator.Validate("2024-03-01T00:00:00Z")
+	if result.IsValid || result.Errors[0].Code != CodeDateFormat {
+		t.Fatalf("Expected format error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Params["layouts"] != "02/01/2006, 2006-01-02" {
+		t.Errorf("Expected layouts in params, got %v", result.Errors[0].Params)
+	}
+
+	// Parsed values are checked against the constraints
+	seconds := (&DateValidator{}).Unix().Min(expected)
+	if result := seconds.Validate(float64(expected.Unix() - 1)); result.IsValid || result.Errors[0].Code != CodeDateMin {
+		t.Errorf("Expected min error for epoch seconds, got %+v", result.Errors)
+	}
+
+	// Epochs are converted exactly, and ones that are not finite or fall
+	// outside the years 1 to 9999 are rejected
+	precise := seconds.Validate(json.Number("1800000000.000000001"))
+	if !precise.IsValid || precise.Value.(time.Time).Nanosecond() != 1 {
+		t.Errorf("Expected nanosecond precision, got %+v", precise)
+	}
+	if result := (&DateValidator{}).Unix().Validate(-1.5); !result.IsValid || !result.Value.(time.Time).Equal(time.Unix(-2, 500000000)) {
+		t.Errorf("Expected -1.5 to be half a second before -1, got %+v", result)
+	}
+	for _, input := range []any{math.Inf(1), math.Inf(-1), math.NaN(), 1e300, json.Number("1e300"), int64(math.MaxInt64), float64(253402300800)} {
+		if result := (&DateValidator{}).Unix().Validate(input); result.IsValid || result.Errors[0].Code != CodeDateFormat {
+			t.Errorf("Expected format error for %v, got %+v", input, result)
+		}
+	}
+	if result := (&DateValidator{}).UnixMilli().Validate(1e300); result.IsValid || result.Errors[0].Params["layouts"] != "Unix milliseconds" {
+		t.Errorf("Expected millisecond format error, got %+v", result.Errors)
+	}
+
+	// Without layouts or Unix strings and numbers are still rejected
+	for _, input := range []any{"2024-03-01", 1709251200} {
+		if result := (&DateValidator{}).Validate(input); result.IsValid || result.Errors[0].Code != CodeDateType {
+			t.Errorf("Expected type error for %v, got %+v", input, result.Errors)
+		}
+	}
+}
+
+func TestDateValidator_AbortEarly(t *testing.T) {
+	saturday := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	validator := (&DateValidator{}).Min(saturday.AddDate(0, 0, 1)).BusinessDay()
+
+	if result := validator.Validate(saturday); len(result.Errors) != 2 {
+		t.Errorf("Expected 2 errors by default, got %+v", result.Errors)
+	}
+	validator = validator.AbortEarly()
+	if result := validator.Validate(saturday); len(result.Errors) != 1 {
+		t.Errorf("Expected 1 error in abort-early mode, got %+v", result.Errors)
+	}
+}
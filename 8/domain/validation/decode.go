@@ -0,0 +1,237 @@
+package validation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// decodeError reports a value that could not be stored in the target type
+type decodeError struct {
+	Path    Path
+	Message string
+}
+
+// decodeInto stores a validated value (maps, slices and scalars as produced
+// by JSON decoding or built by hand) into target, matching struct fields by
+// their json tag or, without one, by field name
+func decodeInto(value any, target any) *decodeError {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() {
+		return &decodeError{Message: fmt.Sprintf("decode target must be a non-nil pointer, got %T", target)}
+	}
+	return decodeValue(value, targetValue.Elem(), nil)
+}
+
+func decodeValue(value any, target reflect.Value, path Path) *decodeError {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	source := reflect.ValueOf(value)
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return nil
+	}
+	if decoded, ok := decodeNumber(value, target.Type()); ok {
+		if !decoded.IsValid() {
+			return decodeMismatch(value, target, path)
+		}
+		target.Set(decoded)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Pointer:
+		element := reflect.New(target.Type().Elem())
+		if err := decodeValue(value, element.Elem(), path); err != nil {
+			return err
+		}
+		target.Set(element)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Integers are converted exactly, e.g. from a large json.Number
+		num, ok := toNumber(value)
+		if !ok || !num.isInt() || !num.rat.Num().IsInt64() || target.OverflowInt(num.rat.Num().Int64()) {
+			return decodeMismatch(value, target, path)
+		}
+		target.SetInt(num.rat.Num().Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		num, ok := toNumber(value)
+		if !ok || !num.isInt() || !num.rat.Num().IsUint64() || target.OverflowUint(num.rat.Num().Uint64()) {
+			return decodeMismatch(value, target, path)
+		}
+		target.SetUint(num.rat.Num().Uint64())
+		return nil
+	case reflect.Float32, reflect.Float64:
+		number, ok := toFloat64(value)
+		if !ok || target.OverflowFloat(number) {
+			return decodeMismatch(value, target, path)
+		}
+		target.SetFloat(number)
+		return nil
+	case reflect.String:
+		if source.Kind() != reflect.String {
+			return decodeMismatch(value, target, path)
+		}
+		target.SetString(source.String())
+		return nil
+	case reflect.Bool:
+		if source.Kind() != reflect.Bool {
+			return decodeMismatch(value, target, path)
+		}
+		target.SetBool(source.Bool())
+		return nil
+	case reflect.Slice:
+		return decodeSlice(source, target, path)
+	case reflect.Map:
+		return decodeMap(source, target, path)
+	case reflect.Struct:
+		return decodeStruct(source, target, path)
+	}
+	return decodeMismatch(value, target, path)
+}
+
+var (
+	bigIntType     = reflect.TypeOf(big.Int{})
+	bigRatType     = reflect.TypeOf(big.Rat{})
+	bigFloatType   = reflect.TypeOf(big.Float{})
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+)
+
+// decodeNumber converts a number exactly into the exact numeric types
+// big.Int, big.Rat, big.Float and json.Number, which would otherwise be
+// decoded as structs or strings. ok is false for other target types, and
+// the result is the zero Value when the number does not fit the target
+func decodeNumber(value any, targetType reflect.Type) (decoded reflect.Value, ok bool) {
+	switch targetType {
+	case bigIntType, bigRatType, bigFloatType, jsonNumberType:
+	default:
+		return reflect.Value{}, false
+	}
+	num, isNumber := toNumber(value)
+	if !isNumber || num.nan || (!num.isFinite() && targetType != bigFloatType) {
+		return reflect.Value{}, true
+	}
+
+	switch targetType {
+	case bigIntType:
+		if !num.isInt() {
+			return reflect.Value{}, true
+		}
+		return reflect.ValueOf(new(big.Int).Set(num.rat.Num())).Elem(), true
+	case bigRatType:
+		return reflect.ValueOf(new(big.Rat).Set(num.rat)).Elem(), true
+	case bigFloatType:
+		if !num.isFinite() {
+			return reflect.ValueOf(new(big.Float).SetInf(num.inf < 0)).Elem(), true
+		}
+		return reflect.ValueOf(new(big.Float).SetRat(num.rat)).Elem(), true
+	default:
+		if num.isInt() {
+			return reflect.ValueOf(json.Number(num.rat.Num().String())), true
+		}
+		return reflect.ValueOf(json.Number(strconv.FormatFloat(num.float(), 'g', -1, 64))), true
+	}
+}
+
+func decodeSlice(source, target reflect.Value, path Path) *decodeError {
+	// Byte slices are base64 strings in JSON
+	if source.Kind() == reflect.String && target.Type().Elem().Kind() == reflect.Uint8 {
+		bytes, err := base64.StdEncoding.DecodeString(source.String())
+		if err != nil {
+			return decodeMismatch(source.Interface(), target, path)
+		}
+		target.SetBytes(bytes)
+		return nil
+	}
+	if source.Kind() != reflect.Slice && source.Kind() != reflect.Array {
+		return decodeMismatch(source.Interface(), target, path)
+	}
+	slice := reflect.MakeSlice(target.Type(), source.Len(), source.Len())
+	for i := 0; i < source.Len(); i++ {
+		if err := decodeValue(source.Index(i).Interface(), slice.Index(i), appendPath(path, Index(i))); err != nil {
+			return err
+		}
+	}
+	target.Set(slice)
+	return nil
+}
+
+func decodeMap(source, target reflect.Value, path Path) *decodeError {
+	if source.Kind() != reflect.Map || target.Type().Key().Kind() != reflect.String {
+		return decodeMismatch(source.Interface(), target, path)
+	}
+	result := reflect.MakeMapWithSize(target.Type(), source.Len())
+	for _, key := range source.MapKeys() {
+		name := fmt.Sprintf("%v", key.Interface())
+		element := reflect.New(target.Type().Elem()).Elem()
+		if err := decodeValue(source.MapIndex(key).Interface(), element, appendPath(path, Key(name))); err != nil {
+			return err
+		}
+		result.SetMapIndex(reflect.ValueOf(name).Convert(target.Type().Key()), element)
+	}
+	target.Set(result)
+	return nil
+}
+
+func decodeStruct(source, target reflect.Value, path Path) *decodeError {
+	if source.Kind() != reflect.Map || source.Type().Key().Kind() != reflect.String {
+		return decodeMismatch(source.Interface(), target, path)
+	}
+	targetType := target.Type()
+	for i := 0; i < targetType.NumField(); i++ {
+		name, embedded, ok := JSONField(targetType.Field(i))
+		if !ok {
+			continue
+		}
+
+		// Embedded structs without a json name share the parent's keys
+		if embedded {
+			embeddedValue := target.Field(i)
+			if embeddedValue.Kind() == reflect.Pointer {
+				if embeddedValue.IsNil() {
+					// Like encoding/json, a nil pointer to an unexported
+					// struct cannot be allocated
+					if !embeddedValue.CanSet() {
+						return &decodeError{Path: path, Message: fmt.Sprintf("cannot set embedded pointer to unexported struct %s", embeddedValue.Type().Elem())}
+					}
+					embeddedValue.Set(reflect.New(embeddedValue.Type().Elem()))
+				}
+				embeddedValue = embeddedValue.Elem()
+			}
+			if err := decodeStruct(source, embeddedValue, path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fieldValue := source.MapIndex(reflect.ValueOf(name).Convert(source.Type().Key()))
+		if !fieldValue.IsValid() {
+			continue
+		}
+		if err := decodeValue(fieldValue.Interface(), target.Field(i), appendPath(path, Key(name))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeMismatch(value any, target reflect.Value, path Path) *decodeError {
+	return &decodeError{
+		Path:    path,
+		Message: fmt.Sprintf("cannot decode %T %v into %s", value, value, target.Type()),
+	}
+}
+
+// appendPath returns a new path with segment appended, leaving path untouched
+func appendPath(path Path, segment PathSegment) Path {
+	result := make(Path, 0, len(path)+1)
+	result = append(result, path...)
+	return append(result, segment)
+}
@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type decodeAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type decodeBase struct {
+	ID string `json:"id"`
+}
+
+type decodeUser struct {
+	decodeBase
+	Name     string            `json:"name"`
+	Age      int               `json:"age"`
+	Score    float32           `json:"score"`
+	Active   bool              `json:"active"`
+	Birthday time.Time         `json:"birthday"`
+	Tags     []string          `json:"tags"`
+	Address  *decodeAddress    `json:"address"`
+	Labels   map[string]string `json:"labels"`
+	Extra    any               `json:"extra"`
+	Nickname string
+	Ignored  string `json:"-"`
+	internal string
+}
+
+func TestDecodeInto_Struct(t *testing.T) {
+	birthday := time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)
+	value := map[string]any{
+		"id":       "u1",
+		"name":     "John",
+		"age":      30.0,
+		"score":    4.5,
+		"active":   true,
+		"birthday": birthday,
+		"tags":     []any{"a", "b"},
+		"address":  map[string]any{"city": "Recife"},
+		"labels":   map[string]any{"en": "Hello"},
+		"extra":    []any{1.0},
+		"Nickname": "JD",
+		"Ignored":  "nope",
+	}
+
+	var user decodeUser
+	if err := decodeInto(value, &user); err != nil {
+		t.Fatalf("decodeInto should succeed, got %+v", err)
+	}
+
+	expected := decodeUser{
+		decodeBase: decodeBase{ID: "u1"},
+		Name:       "John",
+		Age:        30,
+		Score:      4.5,
+		Active:     true,
+		Birthday:   birthday,
+		Tags:       []string{"a", "b"},
+		Address:    &decodeAddress{City: "Recife"},
+		Labels:     map[string]string{"en": "Hello"},
+		Extra:      []any{1.0},
+		Nickname:   "JD",
+	}
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, user)
+	}
+}
+
+func TestDecodeInto_Mismatches(t *testing.T) {
+	testCases := []struct {
+		value any
+		path  string
+	}{
+		{map[string]any{"age": 1.5}, "age"},
+		{map[string]any{"age": "thirty"}, "age"},
+		{map[string]any{"name": 1}, "name"},
+		{map[string]any{"tags": []any{"ok", 2}}, "tags[1]"},
+		{map[string]any{"address": map[string]any{"city": true}}, "address.city"},
+		{"not an object", ""},
+	}
+
+	for _, tc := range testCases {
+		var user decodeUser
+		err := decodeInto(tc.value, &user)
+		if err == nil {
+			t.Errorf("decodeInto(%v) should fail", tc.value)
+			continue
+		}
+		if err.Path.String() != tc.path {
+			t.Errorf("decodeInto(%v): expected path '%s', got '%s'", tc.value, tc.path, err.Path)
+		}
+	}
+}
+
+func TestDecodeInto_IntegerRanges(t *testing.T) {
+	var small int8
+	if err := decodeInto(300.0, &small); err == nil {
+		t.Error("decodeInto should reject values overflowing int8")
+	}
+
+	var unsigned uint
+	if err := decodeInto(-1, &unsigned); err == nil {
+		t.Error("decodeInto should reject negative values for unsigned targets")
+	}
+
+	var large int64
+	if err := decodeInto(int64(1<<62+1), &large); err != nil || large != 1<<62+1 {
+		t.Errorf("decodeInto should keep int64 precision, got %d (%v)", large, err)
+	}
+}
+
+func TestDecodeInto_InvalidTarget(t *testing.T) {
+	var user decodeUser
+	if err := decodeInto(map[string]any{}, user); err == nil {
+		t.Error("decodeInto should reject non-pointer targets")
+	}
+}
+
+func TestDecodeInto_ExactIntegers(t *testing.T) {
+	var target struct {
+		ID    int64  `json:"id"`
+		Count uint64 `json:"count"`
+	}
+	source := map[string]any{"id": json.Number("9007199254740993"), "count": uint64(math.MaxUint64)}
+	if err := decodeInto(source, &target); err != nil {
+		t.Fatalf("Expected exact decode, got %+v", err)
+	}
+	if target.ID != 9007199254740993 || target.Count != math.MaxUint64 {
+		t.Errorf("Expected exact integers, got %+v", target)
+	}
+}
@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DiscriminatedUnionValidator picks one object schema based on the value of
+// a tag field, e.g. "type", and reports errors only from that branch.
+// Branches do not need to declare the tag field themselves
+type DiscriminatedUnionValidator struct {
+	BaseValidator
+	Discriminator string
+	Branches      map[string]*ObjectValidator[map[string]any]
+}
+
+// NewDiscriminatedUnionValidator creates a union keyed on the given tag field
+func NewDiscriminatedUnionValidator(discriminator string, branches map[string]*ObjectValidator[map[string]any]) *DiscriminatedUnionValidator {
+	return &DiscriminatedUnionValidator{Discriminator: discriminator, Branches: branches}
+}
+
+// Tags returns the accepted tag values in sorted order
+func (d *DiscriminatedUnionValidator) Tags() []string {
+	tags := make([]string, 0, len(d.Branches))
+	for tag := range d.Branches {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func (d *DiscriminatedUnionValidator) Validate(value any) ValidationResult {
+	return d.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (d *DiscriminatedUnionValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return d.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (d *DiscriminatedUnionValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Structs may be passed by pointer; a missing value takes the default
+	value = d.applyDefault(indirect(value))
+
+	// Handle nil values for optional validation
+	if value == nil {
+		if d.isOptional() {
+			return ValidationResult{IsValid: true, Errors: nil}
+		}
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				d.newError(opts, CodeUnionRequired, nil),
+			},
+		}
+	}
+
+	// Check if the value is actually a map or a struct
+	if kind := reflect.TypeOf(value).Kind(); kind != reflect.Map && kind != reflect.Struct {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				d.newError(opts, CodeUnionType,
+					map[string]any{"expected": "object", "actual": fmt.Sprintf("%T", value)}),
+			},
+		}
+	}
+	objValue := toObjectMap(value)
+
+	// Read the tag and pick the matching branch
+	tagValue, exists := objValue[d.Discriminator]
+	if !exists || tagValue == nil {
+		tagError := d.newError(opts, CodeUnionMissingTag, map[string]any{
+			"field":   d.Discriminator,
+			"allowed": strings.Join(d.Tags(), ", "),
+		})
+		return ValidationResult{
+			IsValid: false,
+			Errors:  []ValidationError{tagError.prefixed(Key(d.Discriminator))},
+		}
+	}
+	tag, _ := tagValue.(string)
+	branch, ok := d.Branches[tag]
+	if !ok {
+		tagError := d.newError(opts, CodeUnionUnknownTag, map[string]any{
+			"field":   d.Discriminator,
+			"actual":  tagValue,
+			"allowed": strings.Join(d.Tags(), ", "),
+		})
+		return ValidationResult{
+			IsValid: false,
+			Errors:  []ValidationError{tagError.prefixed(Key(d.Discriminator))},
+		}
+	}
+
+	// Branches that do not declare the tag field must not see it as unexpected
+	branchValue := objValue
+	if _, declared := branch.Schema[d.Discriminator]; !declared {
+		branchValue = make(map[string]any, len(objValue))
+		for key, fieldValue := range objValue {
+			if key != d.Discriminator {
+				branchValue[key] = fieldValue
+			}
+		}
+	}
+	result := branch.ValidateWithOptions(branchValue, opts)
+	if !result.IsValid {
+		return result
+	}
+
+	// Run custom checks against the whole output object, tag included
+	output, _ := result.Value.(map[string]any)
+	if _, hasTag := output[d.Discriminator]; !hasTag {
+		withTag := make(map[string]any, len(output)+1)
+		for key, fieldValue := range output {
+			withTag[key] = fieldValue
+		}
+		withTag[d.Discriminator] = tagValue
+		output = withTag
+	}
+	return d.refined(output, opts)
+}
+
+// Refine adds a custom check that receives the object as a map[string]any,
+// tag included, once it matched its branch and returns an error, or nil when
+// the value is acceptable
+func (d *DiscriminatedUnionValidator) Refine(check func(value any) *ValidationError) *DiscriminatedUnionValidator {
+	d = clone(d)
+	d.addRefinement(check)
+	return d
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (d *DiscriminatedUnionValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *DiscriminatedUnionValidator {
+	d = clone(d)
+	d.addContextRefinement(check)
+	return d
+}
+
+// Transform adds a function that turns the valid value into the output
+func (d *DiscriminatedUnionValidator) Transform(transform func(value any) (any, error)) *DiscriminatedUnionValidator {
+	d = clone(d)
+	d.addTransform(transform)
+	return d
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (d *DiscriminatedUnionValidator) Default(value any) *DiscriminatedUnionValidator {
+	d = clone(d)
+	d.setDefault(value)
+	return d
+}
+
+func (d *DiscriminatedUnionValidator) Optional() Validator[any] {
+	d = clone(d)
+	d.setOptional()
+	return d
+}
+
+// Nullable also accepts null, see NullableValidator
+func (d *DiscriminatedUnionValidator) Nullable() *NullableValidator {
+	return nullableOf(d, d.optional)
+}
+
+func (d *DiscriminatedUnionValidator) WithMessage(message string) Validator[any] {
+	d = clone(d)
+	d.setMessage(message)
+	return d
+}
@@ -0,0 +1,123 @@
+package validation
+
+import (
+	"testing"
+)
+
+func eventUnion() *DiscriminatedUnionValidator {
+	return NewDiscriminatedUnionValidator("type", map[string]*ObjectValidator[map[string]any]{
+		"click": {Schema: map[string]AnyValidator{
+			"x": (&NumberValidator{}).Min(0),
+			"y": (&NumberValidator{}).Min(0),
+		}},
+		"keypress": {Schema: map[string]AnyValidator{
+			"type": &StringValidator{},
+			"key":  (&StringValidator{}).MinLength(1),
+		}},
+	})
+}
+
+func TestDiscriminatedUnionValidator_PicksBranch(t *testing.T) {
+	validator := eventUnion()
+
+	valid := []map[string]any{
+		{"type": "click", "x": 1, "y": 2},
+		{"type": "keypress", "key": "a"},
+	}
+	for _, value := range valid {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got errors: %+v", value, result.Errors)
+		}
+	}
+}
+
+func TestDiscriminatedUnionValidator_ReportsOnlyMatchingBranch(t *testing.T) {
+	validator := eventUnion()
+
+	result := validator.Validate(map[string]any{"type": "click", "x": -1, "key": "a"})
+	if result.IsValid {
+		t.Fatal("Expected invalid click event")
+	}
+
+	fields := make(map[string]string)
+	for _, err := range result.Errors {
+		fields[err.Field] = err.Code
+	}
+	expected := map[string]string{
+		"x":   CodeNumberMin,
+		"y":   CodeObjectMissingField,
+		"key": CodeObjectUnexpectedField,
+	}
+	for field, code := range expected {
+		if fields[field] != code {
+			t.Errorf("Expected '%s' at '%s', got '%s'", code, field, fields[field])
+		}
+	}
+	if len(result.Errors) != len(expected) {
+		t.Errorf("Expected %d errors, got %+v", len(expected), result.Errors)
+	}
+}
+
+func TestDiscriminatedUnionValidator_UnknownTag(t *testing.T) {
+	validator := eventUnion()
+
+	result := validator.Validate(map[string]any{"type": "scroll"})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Code != CodeUnionUnknownTag || err.Field != "type" {
+		t.Errorf("Expected unknown tag error at 'type', got %+v", err)
+	}
+	expected := "Unknown type 'scroll', expected one of: click, keypress"
+	if err.Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, err.Message)
+	}
+
+	result = validator.Validate(map[string]any{"type": 42})
+	if result.IsValid || result.Errors[0].Code != CodeUnionUnknownTag {
+		t.Errorf("Expected non-string tag to be unknown, got %+v", result.Errors)
+	}
+}
+
+func TestDiscriminatedUnionValidator_MissingTag(t *testing.T) {
+	validator := eventUnion()
+
+	result := validator.Validate(map[string]any{"x": 1})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeUnionMissingTag || result.Errors[0].Field != "type" {
+		t.Errorf("Expected missing tag error at 'type', got %+v", result.Errors[0])
+	}
+}
+
+func TestDiscriminatedUnionValidator_NilAndType(t *testing.T) {
+	validator := eventUnion()
+
+	if result := validator.Validate(nil); result.IsValid || result.Errors[0].Code != CodeUnionRequired {
+		t.Errorf("Expected required error for nil, got %+v", result.Errors)
+	}
+	if result := validator.Validate("click"); result.IsValid || result.Errors[0].Code != CodeUnionType {
+		t.Errorf("Expected type error for string, got %+v", result.Errors)
+	}
+
+	if result := validator.Optional().Validate(nil); !result.IsValid {
+		t.Error("Optional discriminated union should accept nil")
+	}
+}
+
+func TestDiscriminatedUnionValidator_InArray(t *testing.T) {
+	validator := &ArrayValidator[any]{ItemValidator: eventUnion()}
+
+	result := validator.Validate([]any{
+		map[string]any{"type": "keypress", "key": "a"},
+		map[string]any{"type": "keypress", "key": ""},
+	})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "[1].key" {
+		t.Errorf("Expected error at '[1].key', got '%s'", result.Errors[0].Field)
+	}
+}
@@ -0,0 +1,144 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+)
+
+// EnumValidator validates that a value is one of a fixed set of allowed
+// values. Numbers compare by value regardless of their Go type, so an int 1
+// matches an allowed float64 1 decoded from JSON
+type EnumValidator struct {
+	BaseValidator
+	Values  []any
+	literal bool
+}
+
+// NewEnumValidator creates a validator accepting any of the given values
+func NewEnumValidator(values ...any) *EnumValidator {
+	return &EnumValidator{Values: append([]any(nil), values...)}
+}
+
+// NewLiteralValidator creates a validator accepting exactly one value, like
+// JSON Schema const
+func NewLiteralValidator(value any) *EnumValidator {
+	return &EnumValidator{Values: []any{value}, literal: true}
+}
+
+// Literal returns the single accepted value of a validator created with
+// NewLiteralValidator
+func (e *EnumValidator) Literal() (any, bool) {
+	if !e.literal || len(e.Values) != 1 {
+		return nil, false
+	}
+	return e.Values[0], true
+}
+
+func (e *EnumValidator) Validate(value any) ValidationResult {
+	return e.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (e *EnumValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return e.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (e *EnumValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = e.applyDefault(value)
+
+	// Handle nil values for optional validation
+	if value == nil {
+		if e.isOptional() {
+			return ValidationResult{IsValid: true, Errors: nil}
+		}
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				e.newError(opts, CodeEnumRequired, nil),
+			},
+		}
+	}
+
+	// Check the value against each allowed value
+	for _, allowed := range e.Values {
+		if enumEqual(value, allowed) {
+			return e.refined(value, opts)
+		}
+	}
+
+	if expected, ok := e.Literal(); ok {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				e.newError(opts, CodeLiteralInvalid,
+					map[string]any{"expected": expected, "actual": value}),
+			},
+		}
+	}
+	return ValidationResult{
+		IsValid: false,
+		Errors: []ValidationError{
+			e.newError(opts, CodeEnumInvalid,
+				map[string]any{"allowed": e.Values, "actual": value}),
+		},
+	}
+}
+
+// Refine adds a custom check that receives the value once it matched one of
+// the allowed values and returns an error, or nil when the value is acceptable
+func (e *EnumValidator) Refine(check func(value any) *ValidationError) *EnumValidator {
+	e = clone(e)
+	e.addRefinement(check)
+	return e
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (e *EnumValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *EnumValidator {
+	e = clone(e)
+	e.addContextRefinement(check)
+	return e
+}
+
+// Transform adds a function that turns the valid value into the output
+func (e *EnumValidator) Transform(transform func(value any) (any, error)) *EnumValidator {
+	e = clone(e)
+	e.addTransform(transform)
+	return e
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (e *EnumValidator) Default(value any) *EnumValidator {
+	e = clone(e)
+	e.setDefault(value)
+	return e
+}
+
+func (e *EnumValidator) Optional() Validator[any] {
+	e = clone(e)
+	e.setOptional()
+	return e
+}
+
+// Nullable also accepts null, see NullableValidator
+func (e *EnumValidator) Nullable() *NullableValidator {
+	return nullableOf(e, e.optional)
+}
+
+func (e *EnumValidator) WithMessage(message string) Validator[any] {
+	e = clone(e)
+	e.setMessage(message)
+	return e
+}
+
+// enumEqual compares two values, comparing numbers of any type exactly by
+// value. NaN equals nothing
+func enumEqual(a, b any) bool {
+	if aNum, ok := toNumber(a); ok {
+		bNum, ok := toNumber(b)
+		return ok && !aNum.nan && !bNum.nan && aNum.cmp(bNum) == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestEnumValidator_Validate(t *testing.T) {
+	validator := &EnumValidator{Values: []any{"red", "green", 1.0}}
+
+	for _, value := range []any{"red", "green", 1, 1.0, uint8(1)} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Enum validator should accept allowed value %v (%T)", value, value)
+		}
+	}
+
+	for _, value := range []any{"blue", 2, true, "1"} {
+		result := validator.Validate(value)
+		if result.IsValid {
+			t.Errorf("Enum validator should reject value %v (%T)", value, value)
+			continue
+		}
+		if result.Errors[0].Code != CodeEnumInvalid {
+			t.Errorf("Expected code '%s', got '%s'", CodeEnumInvalid, result.Errors[0].Code)
+		}
+	}
+}
+
+func TestEnumValidator_ExactNumbers(t *testing.T) {
+	validator := NewEnumValidator(int64(9007199254740993), json.Number("0.1"))
+
+	for _, value := range []any{int64(9007199254740993), json.Number("9007199254740993"), uint64(9007199254740993), 0.1, json.Number("0.10")} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Enum validator should accept %v (%T), got %+v", value, value, result.Errors)
+		}
+	}
+	// 2^53 and 2^53+1 are the same float64
+	for _, value := range []any{int64(9007199254740992), json.Number("9007199254740992"), float64(9007199254740992), math.NaN()} {
+		if result := validator.Validate(value); result.IsValid {
+			t.Errorf("Enum validator should reject %v (%T)", value, value)
+		}
+	}
+	if result := NewEnumValidator(math.NaN()).Validate(math.NaN()); result.IsValid {
+		t.Error("NaN should not equal NaN")
+	}
+}
+
+func TestEnumValidator_ValidateNil(t *testing.T) {
+	validator := &EnumValidator{Values: []any{"a"}}
+
+	result := validator.Validate(nil)
+	if result.IsValid {
+		t.Error("Enum validator should reject nil value when not optional")
+	}
+	if result.Errors[0].Code != CodeEnumRequired {
+		t.Errorf("Expected code '%s', got '%s'", CodeEnumRequired, result.Errors[0].Code)
+	}
+
+	validator = validator.Optional().(*EnumValidator)
+	if result := validator.Validate(nil); !result.IsValid {
+		t.Error("Enum validator should accept nil value when optional")
+	}
+}
+
+func TestEnumValidator_ErrorMessage(t *testing.T) {
+	validator := &EnumValidator{Values: []any{"a", "b"}}
+
+	result := validator.Validate("c")
+	expected := "Value must be one of [a b], got c"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+
+	validator = validator.WithMessage("Pick one of {allowed}").(*EnumValidator)
+	result = validator.Validate("c")
+	if result.Errors[0].Message != "Pick one of [a b]" {
+		t.Errorf("Expected templated custom message, got '%s'", result.Errors[0].Message)
+	}
+}
+
+func TestEnumValidator_Literal(t *testing.T) {
+	validator := NewLiteralValidator(2.0)
+
+	if value, ok := validator.Literal(); !ok || value != 2.0 {
+		t.Errorf("Expected literal 2, got %v, %v", value, ok)
+	}
+	if result := validator.Validate(2); !result.IsValid {
+		t.Errorf("Expected 2 to match, got %+v", result.Errors)
+	}
+
+	result := validator.Validate(3)
+	if result.IsValid || result.Errors[0].Code != CodeLiteralInvalid {
+		t.Fatalf("Expected literal error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Message != "Value must be 2, got 3" {
+		t.Errorf("Unexpected message '%s'", result.Errors[0].Message)
+	}
+
+	if _, ok := NewEnumValidator(2.0).Literal(); ok {
+		t.Error("A single-value enum should not report itself as a literal")
+	}
+}
@@ -0,0 +1,107 @@
+package validation
+
+// Error codes are stable, machine-readable identifiers attached to every
+// ValidationError so that clients can branch on the type of failure and
+// localize messages without parsing them
+const (
+	// CodeCustom is the default code for errors reported by Refine and
+	// SuperRefine checks that do not set their own
+	CodeCustom = "custom"
+	// CodeCanceled is reported when the context passed to ValidateContext is
+	// canceled or its deadline expires before validation completes
+	CodeCanceled = "canceled"
+	// CodeTransform is reported when a Transform function returns an error
+	CodeTransform = "transform"
+
+	CodeStringRequired  = "string.required"
+	CodeStringType      = "string.type"
+	CodeStringMinLength = "string.min_length"
+	CodeStringMaxLength = "string.max_length"
+	CodeStringPattern   = "string.pattern"
+	CodeStringEmpty     = "string.empty"
+	CodeStringBlank     = "string.blank"
+
+	CodeStringEmail       = "string.email"
+	CodeStringURL         = "string.url"
+	CodeStringUUID        = "string.uuid"
+	CodeStringIPv4        = "string.ipv4"
+	CodeStringIPv6        = "string.ipv6"
+	CodeStringCIDR        = "string.cidr"
+	CodeStringHostname    = "string.hostname"
+	CodeStringISODate     = "string.iso_date"
+	CodeStringISODateTime = "string.iso_datetime"
+	CodeStringSemver      = "string.semver"
+	CodeStringBase64      = "string.base64"
+	CodeStringHex         = "string.hex"
+	CodeStringJSON        = "string.json"
+
+	CodeNumberRequired     = "number.required"
+	CodeNumberType         = "number.type"
+	CodeNumberMin          = "number.min"
+	CodeNumberMax          = "number.max"
+	CodeNumberExclusiveMin = "number.exclusive_min"
+	CodeNumberExclusiveMax = "number.exclusive_max"
+	CodeNumberInt          = "number.int"
+	CodeNumberPositive     = "number.positive"
+	CodeNumberNegative     = "number.negative"
+	CodeNumberMultipleOf   = "number.multiple_of"
+	CodeNumberFinite       = "number.finite"
+	CodeNumberDecimals     = "number.decimals"
+
+	CodeBooleanRequired = "boolean.required"
+	CodeBooleanType     = "boolean.type"
+
+	CodeDateRequired    = "date.required"
+	CodeDateType        = "date.type"
+	CodeDateFormat      = "date.format"
+	CodeDateMin         = "date.min"
+	CodeDateMax         = "date.max"
+	CodeDateAfter       = "date.after"
+	CodeDateBefore      = "date.before"
+	CodeDatePast        = "date.past"
+	CodeDateFuture      = "date.future"
+	CodeDateWeekday     = "date.weekday"
+	CodeDateBusinessDay = "date.business_day"
+
+	CodeArrayRequired = "array.required"
+	CodeArrayType     = "array.type"
+
+	CodeArrayMinItems    = "array.min_items"
+	CodeArrayMaxItems    = "array.max_items"
+	CodeArrayUnique      = "array.unique"
+	CodeArrayContains    = "array.contains"
+	CodeArrayMaxContains = "array.max_contains"
+	CodeArrayExtraItem   = "array.extra_item"
+
+	CodeRecordRequired      = "record.required"
+	CodeRecordType          = "record.type"
+	CodeRecordInvalidKey    = "record.invalid_key"
+	CodeRecordMinProperties = "record.min_properties"
+	CodeRecordMaxProperties = "record.max_properties"
+
+	CodeEnumRequired = "enum.required"
+	CodeEnumInvalid  = "enum.invalid"
+
+	CodeLiteralInvalid = "literal.invalid"
+
+	CodeLazyUnresolved = "lazy.unresolved"
+	CodeLazyDepth      = "lazy.depth"
+
+	CodeUnionRequired        = "union.required"
+	CodeUnionType            = "union.type"
+	CodeUnionNoMatch         = "union.no_match"
+	CodeUnionMultipleMatches = "union.multiple_matches"
+	CodeUnionMissingTag      = "union.missing_tag"
+	CodeUnionUnknownTag      = "union.unknown_tag"
+
+	CodeObjectRequired          = "object.required"
+	CodeObjectType              = "object.type"
+	CodeObjectMissingField      = "object.missing_field"
+	CodeObjectUnexpectedField   = "object.unexpected_field"
+	CodeObjectDependentRequired = "object.dependent_required"
+	CodeObjectMinProperties     = "object.min_properties"
+	CodeObjectMaxProperties     = "object.max_properties"
+	CodeObjectInvalidKey        = "object.invalid_key"
+	CodeObjectInvalidJSON       = "object.invalid_json"
+	CodeObjectDecode            = "object.decode"
+)
@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// rejectValue returns a Refine check that rejects exactly one value
+func rejectValue(rejected any) func(value any) *ValidationError {
+	return func(value any) *ValidationError {
+		if value == rejected {
+			return &ValidationError{Message: fmt.Sprintf("%v is not allowed", rejected)}
+		}
+		return nil
+	}
+}
+
+func TestBuilders_LeaveReceiverUnchanged(t *testing.T) {
+	base := (&StringValidator{}).MinLength(2)
+
+	bounded := base.MaxLength(3)
+	optional := base.Optional()
+	labeled := base.WithMessage("Too short")
+
+	if result := base.Validate("abcd"); !result.IsValid {
+		t.Errorf("MaxLength should not change the receiver, got %+v", result.Errors)
+	}
+	if result := bounded.Validate("abcd"); result.IsValid {
+		t.Error("Derived validator should reject a string longer than 3 characters")
+	}
+	if result := base.Validate(nil); result.IsValid {
+		t.Error("Optional should not make the receiver optional")
+	}
+	if result := optional.Validate(nil); !result.IsValid {
+		t.Errorf("Optional copy should accept nil, got %+v", result.Errors)
+	}
+	if result := base.Validate("a"); result.Errors[0].Message == "Too short" {
+		t.Error("WithMessage should not change the receiver's message")
+	}
+	if result := labeled.Validate("a"); result.Errors[0].Message != "Too short" {
+		t.Errorf("Expected custom message on the copy, got '%s'", result.Errors[0].Message)
+	}
+}
+
+func TestBuilders_SiblingsDoNotShareChecks(t *testing.T) {
+	// Three refinements leave spare capacity in the slice, which siblings
+	// appending to it in place would overwrite
+	base := (&NumberValidator{}).Refine(rejectValue(1)).Refine(rejectValue(2)).Refine(rejectValue(3))
+
+	withoutFour := base.Refine(rejectValue(4))
+	withoutFive := base.Refine(rejectValue(5))
+
+	if result := withoutFour.Validate(5); !result.IsValid {
+		t.Errorf("Sibling refinement leaked into validator, got %+v", result.Errors)
+	}
+	if result := withoutFour.Validate(4); result.IsValid {
+		t.Error("Expected 4 to be rejected")
+	}
+	if result := withoutFive.Validate(5); result.IsValid {
+		t.Error("Expected 5 to be rejected")
+	}
+	if result := base.Validate(4); !result.IsValid {
+		t.Errorf("Refine should not change the receiver, got %+v", result.Errors)
+	}
+
+	trimmed := (&StringValidator{}).Trim()
+	lower := trimmed.ToLower()
+	if output := trimmed.Validate(" ABC ").Value; output != "ABC" {
+		t.Errorf("ToLower should not change the receiver, got %q", output)
+	}
+	if output := lower.Validate(" ABC ").Value; output != "abc" {
+		t.Errorf("Expected normalized output 'abc', got %q", output)
+	}
+}
+
+func TestBuilders_ObjectRulesLeaveReceiverUnchanged(t *testing.T) {
+	base := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"card_number": (&StringValidator{}).Optional(),
+		"cvv":         (&StringValidator{}).Optional(),
+	}}
+
+	dependent := base.DependentRequired(map[string][]string{"card_number": {"cvv"}})
+	conditional := base.When("card_number", &StringValidator{}).Then(
+		(&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{"cvv": &StringValidator{}}}).Passthrough())
+	passthrough := base.Passthrough()
+
+	value := map[string]any{"card_number": "4111"}
+	if result := base.Validate(value); !result.IsValid {
+		t.Errorf("Object rules should not change the receiver, got %+v", result.Errors)
+	}
+	if result := dependent.Validate(value); result.IsValid {
+		t.Error("Expected dependent field to be required")
+	}
+	if result := conditional.Validate(value); result.IsValid {
+		t.Error("Expected conditional field to be required")
+	}
+	if len(base.Conditions()) != 0 || base.Dependencies().Required != nil {
+		t.Error("Receiver should have no conditions or dependencies")
+	}
+
+	extra := map[string]any{"card_number": "4111", "cvv": "123", "note": "gift"}
+	if result := base.Validate(extra); result.IsValid {
+		t.Error("Passthrough should not change the receiver's unknown-key policy")
+	}
+	if result := passthrough.Validate(extra); !result.IsValid {
+		t.Errorf("Expected passthrough copy to accept unknown fields, got %+v", result.Errors)
+	}
+
+	// Adding to the dependencies of a copy leaves the original rules alone
+	more := dependent.DependentRequired(map[string][]string{"card_number": {"holder"}})
+	if required := dependent.Dependencies().Required["card_number"]; len(required) != 1 {
+		t.Errorf("Expected the original dependency list to be unchanged, got %v", required)
+	}
+	if required := more.Dependencies().Required["card_number"]; len(required) != 2 {
+		t.Errorf("Expected both dependencies on the copy, got %v", required)
+	}
+}
+
+func TestBuilders_CopyVariadicArguments(t *testing.T) {
+	// Changing a slice passed with ... after building must not change the
+	// validator built from it
+	layouts := []string{time.DateOnly}
+	days := []time.Weekday{time.Monday}
+	holidays := []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}
+	dates := (&DateValidator{}).Layouts(layouts...).Weekdays(days...)
+	businessDays := (&DateValidator{}).BusinessDay(holidays...)
+	layouts[0], days[0], holidays[0] = time.RFC3339, time.Tuesday, time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)
+
+	if result := dates.Validate("2024-12-23"); !result.IsValid {
+		t.Errorf("Expected the original layout and weekday to apply, got %+v", result.Errors)
+	}
+	if result := businessDays.Validate(time.Date(2024, 12, 25, 12, 0, 0, 0, time.UTC)); result.IsValid {
+		t.Error("Expected the original holiday to apply")
+	}
+
+	schemes := []string{"https"}
+	versions := []int{4}
+	url := (&StringValidator{}).URL(schemes...)
+	uuid := (&StringValidator{}).UUID(versions...)
+	schemes[0], versions[0] = "ftp", 1
+	if result := url.Validate("https://example.com"); !result.IsValid {
+		t.Errorf("Expected the original scheme to apply, got %+v", result.Errors)
+	}
+	if result := uuid.Validate("f47ac10b-58cc-4372-a567-0e02b2c3d479"); !result.IsValid {
+		t.Errorf("Expected the original UUID version to apply, got %+v", result.Errors)
+	}
+
+	values := []any{"admin"}
+	branches := []AnyValidator{&StringValidator{}}
+	enum := NewEnumValidator(values...)
+	union := NewUnionValidator(branches...)
+	oneOf := NewOneOfValidator(branches...)
+	values[0], branches[0] = "guest", &NumberValidator{}
+	if result := enum.Validate("admin"); !result.IsValid {
+		t.Errorf("Expected the original enum values to apply, got %+v", result.Errors)
+	}
+	if result := union.Validate("text"); !result.IsValid {
+		t.Errorf("Expected the original union branches to apply, got %+v", result.Errors)
+	}
+	if result := oneOf.Validate("text"); !result.IsValid {
+		t.Errorf("Expected the original oneOf branches to apply, got %+v", result.Errors)
+	}
+}
+
+// TestBuilders_ConcurrentUse validates with one shared schema while other
+// goroutines derive variants of it. Run with -race to check that neither
+// writes to the shared validators
+func TestBuilders_ConcurrentUse(t *testing.T) {
+	registry := NewRegistry()
+	registry.Define("category", &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name":     (&StringValidator{}).MinLength(1),
+		"children": (&ArrayValidator[any]{ItemValidator: registry.Ref("category")}).Optional(),
+	}})
+
+	username := (&StringValidator{}).Trim().MinLength(3).Refine(rejectValue("root"))
+	shared := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"username": username,
+		"age":      (&NumberValidator{}).Int().Min(0),
+		"tags":     (&ArrayValidator[any]{ItemValidator: &StringValidator{}}).MaxItems(3),
+		"category": registry.Ref("category"),
+	}}
+
+	valid := map[string]any{
+		"username": " john ",
+		"age":      30,
+		"tags":     []any{"a", "b"},
+		"category": map[string]any{"name": "root", "children": []any{map[string]any{"name": "leaf"}}},
+	}
+	invalid := map[string]any{"username": "root", "age": -1, "tags": []any{"a", "b", "c", "d"}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if result := shared.Validate(valid); !result.IsValid {
+					t.Errorf("Expected shared schema to accept value, got %+v", result.Errors)
+					return
+				}
+				if result := shared.ValidateContext(context.Background(), invalid); result.IsValid {
+					t.Error("Expected shared schema to reject value")
+					return
+				}
+
+				// Derive variants from the shared validators while others
+				// validate with them
+				variant := shared.Extend(map[string]AnyValidator{
+					"username": username.MaxLength(3).WithMessage(fmt.Sprintf("variant %d", i)),
+				}).Strict().Refine(rejectValue(nil)).Optional()
+				if result := variant.Validate(valid); result.IsValid {
+					t.Error("Expected variant to reject a username longer than 3 characters")
+					return
+				}
+				_ = shared.Partial().Pick("username", "age").Passthrough()
+				_ = username.Optional().WithMessage("ignored")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if result := shared.Validate(map[string]any{"username": "john", "age": 1, "tags": []any{}, "category": map[string]any{"name": "x"}}); !result.IsValid {
+		t.Errorf("Deriving variants should not change the shared schema, got %+v", result.Errors)
+	}
+}
@@ -78,18 +78,19 @@ func TestIntegration_AllValidatorsTogether(t *testing.T) {
 			foundActiveErr = true
 		case "birthday":
 			foundBirthdayErr = true
-		case "tags":
+		case "tags[1]":
 			foundTagsErr = true
-		case "address":
+		case "address.city":
 			if err.Message == "Field 'city' is required" {
 				foundAddressCityErr = true
 			}
+		case "address.extra":
+			if err.Message == "Unexpected field 'extra'" {
+				foundAddressExtraErr = true
+			}
 		case "extra_field":
 			foundRootExtraErr = true
 		}
-		if err.Field == "address" && err.Message == "Unexpected field 'extra'" {
-			foundAddressExtraErr = true
-		}
 	}
 	if !foundNameErr {
 		t.Error("Expected error for 'name' field")
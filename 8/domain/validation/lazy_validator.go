@@ -0,0 +1,282 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// MaxLazyDepth bounds how many lazy validators may be nested while
+// validating one value, so that deeply nested or self-referencing input
+// fails with an error instead of exhausting the stack
+const MaxLazyDepth = 512
+
+// LazyValidator defers building its validator until the first validation,
+// which lets schemas refer to themselves, e.g. a category whose children are
+// categories. Validators created by Registry.Ref are named after their
+// definition
+type LazyValidator struct {
+	BaseValidator
+	name  string
+	state *lazyState
+}
+
+// lazyState is shared by a lazy validator and the copies its builder
+// methods return, so that they resolve only once
+type lazyState struct {
+	resolve func() AnyValidator
+
+	mu       sync.Mutex
+	resolved AnyValidator
+}
+
+// NewLazyValidator creates a validator that calls resolve once, when it is
+// first needed
+func NewLazyValidator(resolve func() AnyValidator) *LazyValidator {
+	return &LazyValidator{state: &lazyState{resolve: resolve}}
+}
+
+// Name returns the definition name of validators created by Registry.Ref,
+// and "" for anonymous ones
+func (l *LazyValidator) Name() string {
+	return l.name
+}
+
+// Resolve returns the deferred validator, or nil when it is not available
+// yet, e.g. a reference to a definition that has not been registered
+func (l *LazyValidator) Resolve() AnyValidator {
+	state := l.state
+	state.mu.Lock()
+	resolved := state.resolved
+	state.mu.Unlock()
+	if resolved != nil {
+		return resolved
+	}
+
+	// Resolve outside the lock, so that resolve may build other lazy
+	// validators; concurrent callers keep the first result
+	resolved = state.resolve()
+	if resolved == nil {
+		return nil
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.resolved == nil {
+		state.resolved = resolved
+	}
+	return state.resolved
+}
+
+// Target follows chains of lazy validators to the first validator that
+// does the actual work. It fails on unresolved references and on chains
+// that loop back on themselves
+func (l *LazyValidator) Target() (AnyValidator, error) {
+	seen := map[*lazyState]bool{}
+	current := l
+	for {
+		seen[current.state] = true
+		resolved := current.Resolve()
+		if resolved == nil {
+			return nil, fmt.Errorf("%s is not defined", current.describe())
+		}
+		next, ok := resolved.(*LazyValidator)
+		if !ok {
+			return resolved, nil
+		}
+		if seen[next.state] || (next.name != "" && next.name == l.name) {
+			return nil, fmt.Errorf("%s refers to itself without validating anything", l.describe())
+		}
+		current = next
+	}
+}
+
+func (l *LazyValidator) describe() string {
+	if l.name != "" {
+		return fmt.Sprintf("definition %q", l.name)
+	}
+	return "lazy validator"
+}
+
+func (l *LazyValidator) Validate(value any) ValidationResult {
+	return l.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (l *LazyValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return l.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (l *LazyValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = l.applyDefault(value)
+
+	// Handle nil values for optional validation; required values are
+	// checked by the target validator
+	if value == nil && l.isOptional() {
+		return ValidationResult{IsValid: true, Errors: nil}
+	}
+
+	target, err := l.Target()
+	if err != nil {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				l.newError(opts, CodeLazyUnresolved, map[string]any{"reason": err.Error()}),
+			},
+		}
+	}
+	if opts.depth >= MaxLazyDepth {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				l.newError(opts, CodeLazyDepth, map[string]any{"max": MaxLazyDepth}),
+			},
+		}
+	}
+
+	nested := opts
+	nested.depth++
+	result := validateWithOptions(target, value, nested)
+	if !result.IsValid {
+		return result
+	}
+	return l.refined(outputOf(result, value), opts)
+}
+
+// Refine adds a custom check that receives the value once the deferred
+// validator accepted it and returns an error, or nil when the value is
+// acceptable
+func (l *LazyValidator) Refine(check func(value any) *ValidationError) *LazyValidator {
+	l = clone(l)
+	l.addRefinement(check)
+	return l
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (l *LazyValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *LazyValidator {
+	l = clone(l)
+	l.addContextRefinement(check)
+	return l
+}
+
+// Transform adds a function that turns the valid value into the output
+func (l *LazyValidator) Transform(transform func(value any) (any, error)) *LazyValidator {
+	l = clone(l)
+	l.addTransform(transform)
+	return l
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (l *LazyValidator) Default(value any) *LazyValidator {
+	l = clone(l)
+	l.setDefault(value)
+	return l
+}
+
+func (l *LazyValidator) Optional() Validator[any] {
+	l = clone(l)
+	l.setOptional()
+	return l
+}
+
+// Nullable also accepts null, see NullableValidator
+func (l *LazyValidator) Nullable() *NullableValidator {
+	return nullableOf(l, l.optional)
+}
+
+func (l *LazyValidator) WithMessage(message string) Validator[any] {
+	l = clone(l)
+	l.setMessage(message)
+	return l
+}
+
+// Registry holds named validator definitions that validators refer to with
+// Ref, including before the definition is registered. It is safe for
+// concurrent use
+type Registry struct {
+	mu          sync.RWMutex
+	definitions map[string]AnyValidator
+	referenced  map[string]bool
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{definitions: make(map[string]AnyValidator), referenced: make(map[string]bool)}
+}
+
+// Define registers validator under name. It panics when the name is
+// already defined
+func (r *Registry) Define(name string, validator AnyValidator) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.definitions[name]; exists {
+		panic(fmt.Sprintf("validation: definition %q is already registered", name))
+	}
+	r.definitions[name] = validator
+	return r
+}
+
+// Lookup returns the validator defined under name
+func (r *Registry) Lookup(name string) (AnyValidator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	validator, ok := r.definitions[name]
+	return validator, ok
+}
+
+// Names returns the defined names in sorted order
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.definitions))
+	for name := range r.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Ref returns a validator for the definition registered under name
+func (r *Registry) Ref(name string) *LazyValidator {
+	r.mu.Lock()
+	r.referenced[name] = true
+	r.mu.Unlock()
+	return &LazyValidator{name: name, state: &lazyState{resolve: func() AnyValidator {
+		validator, _ := r.Lookup(name)
+		return validator
+	}}}
+}
+
+// Check reports references to names that are not defined and definitions
+// that only refer to themselves, so that schemas fail when they are built
+// rather than on the first value
+func (r *Registry) Check() error {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.referenced)+len(r.definitions))
+	for name := range r.referenced {
+		names = append(names, name)
+	}
+	for name := range r.definitions {
+		if !r.referenced[name] {
+			names = append(names, name)
+		}
+	}
+	r.mu.RUnlock()
+	sort.Strings(names)
+
+	for _, name := range names {
+		validator, ok := r.Lookup(name)
+		if !ok {
+			return fmt.Errorf("definition %q is referenced but not defined", name)
+		}
+		if lazy, ok := validator.(*LazyValidator); ok {
+			if _, err := lazy.Target(); err != nil {
+				return fmt.Errorf("definition %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
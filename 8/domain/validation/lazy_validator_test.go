@@ -0,0 +1,114 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func categoryRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Define("category", &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name":     (&StringValidator{}).MinLength(1),
+		"children": (&ArrayValidator[any]{ItemValidator: registry.Ref("category")}).Optional(),
+	}})
+	return registry
+}
+
+func TestLazyValidator_RecursiveTree(t *testing.T) {
+	validator := categoryRegistry().Ref("category")
+
+	tree := map[string]any{
+		"name": "root",
+		"children": []any{
+			map[string]any{"name": "books", "children": []any{map[string]any{"name": "fiction"}}},
+			map[string]any{"name": "music"},
+		},
+	}
+	if result := validator.Validate(tree); !result.IsValid {
+		t.Fatalf("Expected tree to be valid, got %+v", result.Errors)
+	}
+
+	tree["children"].([]any)[0].(map[string]any)["children"] = []any{map[string]any{"name": ""}}
+	result := validator.Validate(tree)
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "children[0].children[0].name" {
+		t.Errorf("Expected nested path, got %q", result.Errors[0].Field)
+	}
+}
+
+func TestLazyValidator_Anonymous(t *testing.T) {
+	var node AnyValidator
+	node = &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"value": &NumberValidator{},
+		"next":  NewLazyValidator(func() AnyValidator { return node }).Optional(),
+	}}
+
+	list := map[string]any{"value": 1, "next": map[string]any{"value": 2, "next": map[string]any{"value": "3"}}}
+	result := node.Validate(list)
+	if result.IsValid || result.Errors[0].Field != "next.next.value" {
+		t.Errorf("Expected error at next.next.value, got %+v", result.Errors)
+	}
+}
+
+func TestLazyValidator_UnresolvedAndCycles(t *testing.T) {
+	registry := NewRegistry()
+	missing := registry.Ref("missing")
+	result := missing.Validate("x")
+	if result.IsValid || result.Errors[0].Code != CodeLazyUnresolved {
+		t.Errorf("Expected unresolved error, got %+v", result.Errors)
+	}
+	if err := registry.Check(); err == nil || !strings.Contains(err.Error(), `"missing"`) {
+		t.Errorf("Expected Check to report the missing definition, got %v", err)
+	}
+
+	// Definitions that only point at each other never validate anything
+	registry = NewRegistry()
+	registry.Define("a", registry.Ref("b")).Define("b", registry.Ref("a"))
+	if err := registry.Check(); err == nil {
+		t.Error("Expected Check to report the reference cycle")
+	}
+	result = registry.Ref("a").Validate("x")
+	if result.IsValid || result.Errors[0].Code != CodeLazyUnresolved {
+		t.Errorf("Expected cycle error instead of a stack overflow, got %+v", result.Errors)
+	}
+
+	if err := categoryRegistry().Check(); err != nil {
+		t.Errorf("Expected recursion through an object to be accepted, got %v", err)
+	}
+}
+
+func TestLazyValidator_Depth(t *testing.T) {
+	registry := NewRegistry()
+	registry.Define("list", &ArrayValidator[any]{ItemValidator: registry.Ref("list")})
+
+	var value any = []any{}
+	for i := 0; i < MaxLazyDepth+10; i++ {
+		value = []any{value}
+	}
+	result := registry.Ref("list").Validate(value)
+	if result.IsValid {
+		t.Fatal("Expected deeply nested value to be rejected")
+	}
+	if result.Errors[0].Code != CodeLazyDepth {
+		t.Errorf("Expected depth error, got %+v", result.Errors[0])
+	}
+}
+
+func TestRegistry_Define(t *testing.T) {
+	registry := NewRegistry().Define("b", &StringValidator{}).Define("a", &NumberValidator{})
+	if names := registry.Names(); len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Errorf("Expected sorted names, got %v", names)
+	}
+	if _, ok := registry.Lookup("a"); !ok {
+		t.Error("Expected a to be defined")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected duplicate definition to panic")
+		}
+	}()
+	registry.Define("a", &StringValidator{})
+}
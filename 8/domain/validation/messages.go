@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultLocale is used when no locale is given or the requested locale has
+// no message for an error code
+const DefaultLocale = "en"
+
+// Translator resolves the message template for an error code in a locale.
+// Templates may reference error params as {name} or, with an explicit fmt
+// verb, as {name:%f}
+type Translator interface {
+	Translate(locale, code string) (string, bool)
+}
+
+// Catalog is a Translator backed by message templates keyed by locale and
+// then by error code
+type Catalog map[string]map[string]string
+
+// Translate looks the code up in the exact locale first and then in its base
+// language, so "pt-BR" falls back to "pt"
+func (c Catalog) Translate(locale, code string) (string, bool) {
+	if template, ok := c[locale][code]; ok {
+		return template, true
+	}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		if template, ok := c[base][code]; ok {
+			return template, true
+		}
+	}
+	return "", false
+}
+
+// DefaultCatalog bundles the built-in English and Portuguese messages
+var DefaultCatalog = Catalog{
+	"en": messagesEN,
+	"pt": messagesPT,
+}
+
+// Options configures a single validation call
+type Options struct {
+	// Locale selects the message language, DefaultLocale when empty
+	Locale string
+	// Translator provides message templates, DefaultCatalog when nil
+	Translator Translator
+	// Context carries cancellation and deadlines to RefineContext checks.
+	// When set, object fields and array items are validated concurrently
+	Context context.Context
+	// Concurrency limits how many goroutines one call starts on the context
+	// path, across the whole tree, DefaultConcurrency when zero or negative.
+	// Validations beyond the limit run in the goroutine that reached them
+	Concurrency int
+
+	// depth counts the lazy validators entered so far, see MaxLazyDepth
+	depth int
+	// slots is shared by the whole call and holds a token per running
+	// goroutine, see Concurrency
+	slots chan struct{}
+}
+
+// DefaultConcurrency is the number of goroutines a validation on the context
+// path starts at most when Options.Concurrency is not set
+const DefaultConcurrency = 16
+
+// ctx returns the call's context, context.Background() when none is set
+func (o Options) ctx() context.Context {
+	if o.Context == nil {
+		return context.Background()
+	}
+	return o.Context
+}
+
+// template resolves the message template for code, falling back to the
+// default locale and finally to the bare code
+func (o Options) template(code string) string {
+	translator := o.Translator
+	if translator == nil {
+		translator = DefaultCatalog
+	}
+	locale := o.Locale
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	if template, ok := translator.Translate(locale, code); ok {
+		return template
+	}
+	if template, ok := translator.Translate(DefaultLocale, code); ok {
+		return template
+	}
+	return code
+}
+
+var placeholderRegex = regexp.MustCompile(`\{(\w+)(?::(%[^}]+))?\}`)
+
+// formatMessage replaces {name} and {name:%verb} placeholders with the
+// matching params; unknown placeholders are left untouched
+func formatMessage(template string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	return placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		match := placeholderRegex.FindStringSubmatch(placeholder)
+		value, ok := params[match[1]]
+		if !ok {
+			return placeholder
+		}
+		verb := match[2]
+		if verb == "" {
+			verb = "%v"
+		}
+		return fmt.Sprintf(verb, value)
+	})
+}
@@ -0,0 +1,100 @@
+package validation
+
+// messagesEN holds the default English message templates
+var messagesEN = map[string]string{
+	CodeCustom:    "Invalid value",
+	CodeCanceled:  "Validation canceled: {reason}",
+	CodeTransform: "Cannot transform value: {reason}",
+
+	CodeStringRequired:  "String value is required",
+	CodeStringType:      "Expected string value, got {actual}",
+	CodeStringMinLength: "String must be at least {min} characters long",
+	CodeStringMaxLength: "String must be at most {max} characters long",
+	CodeStringPattern:   "String must match pattern: {pattern}",
+	CodeStringEmpty:     "String must not be empty",
+	CodeStringBlank:     "String must not be blank",
+
+	CodeStringEmail:       "String must be a valid email address",
+	CodeStringURL:         "String must be a valid URL",
+	CodeStringUUID:        "String must be a valid UUID",
+	CodeStringIPv4:        "String must be a valid IPv4 address",
+	CodeStringIPv6:        "String must be a valid IPv6 address",
+	CodeStringCIDR:        "String must be a valid CIDR range",
+	CodeStringHostname:    "String must be a valid hostname",
+	CodeStringISODate:     "String must be an ISO 8601 date (YYYY-MM-DD)",
+	CodeStringISODateTime: "String must be an ISO 8601 date-time",
+	CodeStringSemver:      "String must be a semantic version",
+	CodeStringBase64:      "String must be valid base64",
+	CodeStringHex:         "String must be hexadecimal",
+	CodeStringJSON:        "String must be valid JSON",
+
+	CodeNumberRequired:     "Number value is required",
+	CodeNumberType:         "Expected numeric value, got {actual}",
+	CodeNumberMin:          "Number must be at least {min:%f}",
+	CodeNumberMax:          "Number must be at most {max:%f}",
+	CodeNumberExclusiveMin: "Number must be greater than {min}",
+	CodeNumberExclusiveMax: "Number must be less than {max}",
+	CodeNumberInt:          "Number must be an integer",
+	CodeNumberPositive:     "Number must be positive",
+	CodeNumberNegative:     "Number must be negative",
+	CodeNumberMultipleOf:   "Number must be a multiple of {step}",
+	CodeNumberFinite:       "Number must be finite",
+	CodeNumberDecimals:     "Number must have at most {decimals} decimal places",
+
+	CodeBooleanRequired: "Boolean value is required",
+	CodeBooleanType:     "Expected boolean value, got {actual}",
+
+	CodeDateRequired:    "Date value is required",
+	CodeDateType:        "Expected time.Time value, got {actual}",
+	CodeDateFormat:      "Date '{actual}' must match one of the formats: {layouts}",
+	CodeDateMin:         "Date must be on or after {min}",
+	CodeDateMax:         "Date must be on or before {max}",
+	CodeDateAfter:       "Date must be after {after}",
+	CodeDateBefore:      "Date must be before {before}",
+	CodeDatePast:        "Date must be in the past",
+	CodeDateFuture:      "Date must be in the future",
+	CodeDateWeekday:     "Date must fall on {allowed}, got {actual}",
+	CodeDateBusinessDay: "Date {actual} is not a business day",
+
+	CodeArrayRequired: "Array value is required",
+	CodeArrayType:     "Expected array/slice value, got {actual}",
+
+	CodeArrayMinItems:    "Array must contain at least {min} items",
+	CodeArrayMaxItems:    "Array must contain at most {max} items",
+	CodeArrayUnique:      "Array items must be unique; this item repeats item {first}",
+	CodeArrayContains:    "Array must contain at least {min} matching items, found {actual}",
+	CodeArrayMaxContains: "Array must contain at most {max} matching items, found {actual}",
+	CodeArrayExtraItem:   "Unexpected item; the tuple has {max} items",
+
+	CodeRecordRequired:      "Object value is required",
+	CodeRecordType:          "Expected object value, got {actual}",
+	CodeRecordInvalidKey:    "Invalid key '{key}': {reason}",
+	CodeRecordMinProperties: "Object must contain at least {min} entries",
+	CodeRecordMaxProperties: "Object must contain at most {max} entries",
+
+	CodeEnumRequired: "Value is required",
+	CodeEnumInvalid:  "Value must be one of {allowed}, got {actual}",
+
+	CodeLiteralInvalid: "Value must be {expected}, got {actual}",
+
+	CodeLazyUnresolved: "Schema cannot be resolved: {reason}",
+	CodeLazyDepth:      "Value is nested more than {max} levels deep",
+
+	CodeUnionRequired:        "Value is required",
+	CodeUnionType:            "Expected {expected}, got {actual}",
+	CodeUnionNoMatch:         "Value does not match any of the {branches} candidate schemas",
+	CodeUnionMultipleMatches: "Value must match exactly one schema, but matched {matches}",
+	CodeUnionMissingTag:      "Field '{field}' is required and must be one of: {allowed}",
+	CodeUnionUnknownTag:      "Unknown {field} '{actual}', expected one of: {allowed}",
+
+	CodeObjectRequired:          "Object value is required",
+	CodeObjectType:              "Expected object value, got {actual}",
+	CodeObjectMissingField:      "Field '{field}' is required",
+	CodeObjectUnexpectedField:   "Unexpected field '{field}'",
+	CodeObjectDependentRequired: "Field '{field}' is required when '{dependency}' is present",
+	CodeObjectMinProperties:     "Object must contain at least {min} fields",
+	CodeObjectMaxProperties:     "Object must contain at most {max} fields",
+	CodeObjectInvalidKey:        "Invalid field name '{key}': {reason}",
+	CodeObjectInvalidJSON:       "Invalid JSON: {reason}",
+	CodeObjectDecode:            "Cannot decode into {type}: {reason}",
+}
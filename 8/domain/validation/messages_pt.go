@@ -0,0 +1,100 @@
+package validation
+
+// messagesPT holds the Portuguese message templates
+var messagesPT = map[string]string{
+	CodeCustom:    "Valor inválido",
+	CodeCanceled:  "Validação cancelada: {reason}",
+	CodeTransform: "Não foi possível transformar o valor: {reason}",
+
+	CodeStringRequired:  "O texto é obrigatório",
+	CodeStringType:      "Esperado um texto, recebido {actual}",
+	CodeStringMinLength: "O texto deve ter pelo menos {min} caracteres",
+	CodeStringMaxLength: "O texto deve ter no máximo {max} caracteres",
+	CodeStringPattern:   "O texto deve corresponder ao padrão: {pattern}",
+	CodeStringEmpty:     "O texto não pode estar vazio",
+	CodeStringBlank:     "O texto não pode estar em branco",
+
+	CodeStringEmail:       "O texto deve ser um endereço de e-mail válido",
+	CodeStringURL:         "O texto deve ser uma URL válida",
+	CodeStringUUID:        "O texto deve ser um UUID válido",
+	CodeStringIPv4:        "O texto deve ser um endereço IPv4 válido",
+	CodeStringIPv6:        "O texto deve ser um endereço IPv6 válido",
+	CodeStringCIDR:        "O texto deve ser uma faixa CIDR válida",
+	CodeStringHostname:    "O texto deve ser um nome de host válido",
+	CodeStringISODate:     "O texto deve ser uma data ISO 8601 (AAAA-MM-DD)",
+	CodeStringISODateTime: "O texto deve ser uma data e hora ISO 8601",
+	CodeStringSemver:      "O texto deve ser uma versão semântica",
+	CodeStringBase64:      "O texto deve estar em base64 válido",
+	CodeStringHex:         "O texto deve ser hexadecimal",
+	CodeStringJSON:        "O texto deve ser um JSON válido",
+
+	CodeNumberRequired:     "O número é obrigatório",
+	CodeNumberType:         "Esperado um número, recebido {actual}",
+	CodeNumberMin:          "O número deve ser no mínimo {min}",
+	CodeNumberMax:          "O número deve ser no máximo {max}",
+	CodeNumberExclusiveMin: "O número deve ser maior que {min}",
+	CodeNumberExclusiveMax: "O número deve ser menor que {max}",
+	CodeNumberInt:          "O número deve ser inteiro",
+	CodeNumberPositive:     "O número deve ser positivo",
+	CodeNumberNegative:     "O número deve ser negativo",
+	CodeNumberMultipleOf:   "O número deve ser múltiplo de {step}",
+	CodeNumberFinite:       "O número deve ser finito",
+	CodeNumberDecimals:     "O número deve ter no máximo {decimals} casas decimais",
+
+	CodeBooleanRequired: "O valor booleano é obrigatório",
+	CodeBooleanType:     "Esperado um valor booleano, recebido {actual}",
+
+	CodeDateRequired:    "A data é obrigatória",
+	CodeDateType:        "Esperado um valor time.Time, recebido {actual}",
+	CodeDateFormat:      "A data '{actual}' deve seguir um dos formatos: {layouts}",
+	CodeDateMin:         "A data deve ser igual ou posterior a {min}",
+	CodeDateMax:         "A data deve ser igual ou anterior a {max}",
+	CodeDateAfter:       "A data deve ser posterior a {after}",
+	CodeDateBefore:      "A data deve ser anterior a {before}",
+	CodeDatePast:        "A data deve estar no passado",
+	CodeDateFuture:      "A data deve estar no futuro",
+	CodeDateWeekday:     "A data deve cair em {allowed}, recebido {actual}",
+	CodeDateBusinessDay: "A data {actual} não é um dia útil",
+
+	CodeArrayRequired: "A lista é obrigatória",
+	CodeArrayType:     "Esperado um array/slice, recebido {actual}",
+
+	CodeArrayMinItems:    "A lista deve conter pelo menos {min} itens",
+	CodeArrayMaxItems:    "A lista deve conter no máximo {max} itens",
+	CodeArrayUnique:      "Os itens da lista devem ser únicos; este item repete o item {first}",
+	CodeArrayContains:    "A lista deve conter pelo menos {min} itens correspondentes, encontrados {actual}",
+	CodeArrayMaxContains: "A lista deve conter no máximo {max} itens correspondentes, encontrados {actual}",
+	CodeArrayExtraItem:   "Item inesperado; a tupla tem {max} itens",
+
+	CodeRecordRequired:      "O objeto é obrigatório",
+	CodeRecordType:          "Esperado um objeto, recebido {actual}",
+	CodeRecordInvalidKey:    "Chave inválida '{key}': {reason}",
+	CodeRecordMinProperties: "O objeto deve conter pelo menos {min} entradas",
+	CodeRecordMaxProperties: "O objeto deve conter no máximo {max} entradas",
+
+	CodeEnumRequired: "O valor é obrigatório",
+	CodeEnumInvalid:  "O valor deve ser um de {allowed}, recebido {actual}",
+
+	CodeLiteralInvalid: "O valor deve ser {expected}, recebido {actual}",
+
+	CodeLazyUnresolved: "O esquema não pode ser resolvido: {reason}",
+	CodeLazyDepth:      "O valor está aninhado em mais de {max} níveis",
+
+	CodeUnionRequired:        "O valor é obrigatório",
+	CodeUnionType:            "Esperado {expected}, recebido {actual}",
+	CodeUnionNoMatch:         "O valor não corresponde a nenhum dos {branches} esquemas candidatos",
+	CodeUnionMultipleMatches: "O valor deve corresponder a exatamente um esquema, mas corresponde a {matches}",
+	CodeUnionMissingTag:      "O campo '{field}' é obrigatório e deve ser um de: {allowed}",
+	CodeUnionUnknownTag:      "Valor de {field} desconhecido '{actual}', esperado um de: {allowed}",
+
+	CodeObjectRequired:          "O objeto é obrigatório",
+	CodeObjectType:              "Esperado um objeto, recebido {actual}",
+	CodeObjectMissingField:      "O campo '{field}' é obrigatório",
+	CodeObjectUnexpectedField:   "Campo inesperado '{field}'",
+	CodeObjectDependentRequired: "O campo '{field}' é obrigatório quando '{dependency}' está presente",
+	CodeObjectMinProperties:     "O objeto deve conter pelo menos {min} campos",
+	CodeObjectMaxProperties:     "O objeto deve conter no máximo {max} campos",
+	CodeObjectInvalidKey:        "Nome de campo inválido '{key}': {reason}",
+	CodeObjectInvalidJSON:       "JSON inválido: {reason}",
+	CodeObjectDecode:            "Não foi possível converter para {type}: {reason}",
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestMessages_DefaultLocaleIsEnglish(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(2)
+
+	result := validator.ValidateWithOptions("a", Options{})
+	if result.IsValid {
+		t.Fatal("String validator should reject short string")
+	}
+	expected := "String must be at least 2 characters long"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_PortugueseLocale(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(2)
+
+	result := validator.ValidateWithOptions("a", Options{Locale: "pt"})
+	expected := "O texto deve ter pelo menos 2 caracteres"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+
+	// Regional variants fall back to the base language
+	result = validator.ValidateWithOptions("a", Options{Locale: "pt-BR"})
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s' for pt-BR, got '%s'", expected, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_UnknownLocaleFallsBackToEnglish(t *testing.T) {
+	validator := &NumberValidator{}
+
+	result := validator.ValidateWithOptions(nil, Options{Locale: "xx"})
+	expected := "Number value is required"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_LocalePropagatesToNestedValidators(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{
+		Schema: map[string]AnyValidator{
+			"tags": &ArrayValidator[any]{ItemValidator: &StringValidator{}},
+		},
+	}
+
+	result := validator.ValidateWithOptions(map[string]any{"tags": []any{1}}, Options{Locale: "pt"})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	expected := "Esperado um texto, recebido int"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_TemplatedOverride(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(3).WithMessage("Need {min} characters, got {actual}")
+
+	result := validator.Validate("ab")
+	expected := "Need 3 characters, got 2"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+
+	patternValidator := (&StringValidator{}).Pattern(`^\d{5}$`).WithMessage("Value must match {pattern}")
+	result = patternValidator.Validate("abc")
+	expected = `Value must match ^\d{5}$`
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_CustomTranslator(t *testing.T) {
+	catalog := Catalog{
+		"es": {CodeNumberMax: "El número debe ser como máximo {max:%.1f}"},
+	}
+	validator := (&NumberValidator{}).Max(10)
+
+	result := validator.ValidateWithOptions(11, Options{Locale: "es", Translator: catalog})
+	expected := "El número debe ser como máximo 10.0"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+
+	// Codes missing from the custom catalog render as the bare code
+	result = validator.ValidateWithOptions("x", Options{Locale: "es", Translator: catalog})
+	if result.Errors[0].Message != CodeNumberType {
+		t.Errorf("Expected message '%s', got '%s'", CodeNumberType, result.Errors[0].Message)
+	}
+}
+
+func TestMessages_CatalogsCoverEveryCode(t *testing.T) {
+	for code := range messagesEN {
+		if _, ok := messagesPT[code]; !ok {
+			t.Errorf("Portuguese catalog is missing code '%s'", code)
+		}
+	}
+	for code := range messagesPT {
+		if _, ok := messagesEN[code]; !ok {
+			t.Errorf("English catalog is missing code '%s'", code)
+		}
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	params := map[string]any{"min": 2.5, "field": "name"}
+
+	testCases := map[string]string{
+		"plain message":        "plain message",
+		"at least {min}":       "at least 2.5",
+		"at least {min:%.2f}":  "at least 2.50",
+		"field '{field}'":      "field 'name'",
+		"unknown {missing}":    "unknown {missing}",
+		`pattern ^\d{5}$ kept`: `pattern ^\d{5}$ kept`,
+	}
+
+	for template, expected := range testCases {
+		if got := formatMessage(template, params); got != expected {
+			t.Errorf("formatMessage(%q): expected '%s', got '%s'", template, expected, got)
+		}
+	}
+}
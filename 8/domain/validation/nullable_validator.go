@@ -0,0 +1,93 @@
+package validation
+
+import "context"
+
+// NullableValidator accepts null (nil) in addition to the values accepted by
+// its inner validator. Unlike Optional, a nullable object field must still
+// be present, matching JSON Schema's {"type": ["string", "null"]} with the
+// property listed in "required"
+type NullableValidator struct {
+	BaseValidator
+	Inner AnyValidator
+}
+
+// NewNullableValidator wraps inner so that nil is also accepted
+func NewNullableValidator(inner AnyValidator) *NullableValidator {
+	return &NullableValidator{Inner: inner}
+}
+
+// nullableOf wraps the receiver of a builder's Nullable method, which stays
+// optional when the builder was
+func nullableOf(inner AnyValidator, optional bool) *NullableValidator {
+	return &NullableValidator{BaseValidator: BaseValidator{optional: optional}, Inner: inner}
+}
+
+func (n *NullableValidator) Validate(value any) ValidationResult {
+	return n.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (n *NullableValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return n.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (n *NullableValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = n.applyDefault(value)
+
+	// Null is always accepted and passed through unchanged
+	if value == nil {
+		return ValidationResult{IsValid: true, Errors: nil}
+	}
+
+	result := validateWithOptions(n.Inner, value, opts)
+	if !result.IsValid {
+		return result
+	}
+	return n.refined(outputOf(result, value), opts)
+}
+
+// Refine adds a custom check that receives non-null values once the inner
+// validator accepted them and returns an error, or nil when the value is
+// acceptable
+func (n *NullableValidator) Refine(check func(value any) *ValidationError) *NullableValidator {
+	n = clone(n)
+	n.addRefinement(check)
+	return n
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (n *NullableValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *NullableValidator {
+	n = clone(n)
+	n.addContextRefinement(check)
+	return n
+}
+
+// Transform adds a function that turns a valid non-null value into the output
+func (n *NullableValidator) Transform(transform func(value any) (any, error)) *NullableValidator {
+	n = clone(n)
+	n.addTransform(transform)
+	return n
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (n *NullableValidator) Default(value any) *NullableValidator {
+	n = clone(n)
+	n.setDefault(value)
+	return n
+}
+
+// Optional also accepts a missing object field
+func (n *NullableValidator) Optional() Validator[any] {
+	n = clone(n)
+	n.setOptional()
+	return n
+}
+
+func (n *NullableValidator) WithMessage(message string) Validator[any] {
+	n = clone(n)
+	n.setMessage(message)
+	return n
+}
@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestNullableValidator_Validate(t *testing.T) {
+	validator := NewNullableValidator((&StringValidator{}).MinLength(2))
+
+	for _, value := range []any{nil, "ok"} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got %+v", value, result.Errors)
+		}
+	}
+	result := validator.Validate("x")
+	if result.IsValid || result.Errors[0].Code != CodeStringMinLength {
+		t.Errorf("Expected inner min length error, got %+v", result.Errors)
+	}
+}
+
+func TestNullableValidator_ObjectField(t *testing.T) {
+	object := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"notes": NewNullableValidator(&StringValidator{}),
+	}}
+
+	if result := object.Validate(map[string]any{"notes": nil}); !result.IsValid {
+		t.Errorf("Expected null notes to be valid, got %+v", result.Errors)
+	}
+	// Nullable fields must still be present
+	result := object.Validate(map[string]any{})
+	if result.IsValid || result.Errors[0].Code != CodeObjectMissingField {
+		t.Errorf("Expected missing field error, got %+v", result.Errors)
+	}
+
+	object.Schema["notes"] = object.Schema["notes"].(*NullableValidator).Optional()
+	if result := object.Validate(map[string]any{}); !result.IsValid {
+		t.Errorf("Expected optional nullable field to be skippable, got %+v", result.Errors)
+	}
+}
+
+func TestNullableValidator_Output(t *testing.T) {
+	validator := NewNullableValidator((&StringValidator{}).Trim()).Refine(func(value any) *ValidationError {
+		if value == "" {
+			return &ValidationError{Message: "Use null instead of an empty string"}
+		}
+		return nil
+	})
+
+	if result := validator.Validate(" hi "); !result.IsValid || result.Value != "hi" {
+		t.Errorf("Expected trimmed output, got %+v", result)
+	}
+	if result := validator.Validate("  "); result.IsValid || result.Errors[0].Code != CodeCustom {
+		t.Errorf("Expected refinement error, got %+v", result.Errors)
+	}
+	if result := validator.Validate(nil); !result.IsValid || result.Value != nil {
+		t.Errorf("Expected null to pass through, got %+v", result)
+	}
+}
+
+func TestNullableValidator_Modifier(t *testing.T) {
+	object := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"rating": (&NumberValidator{}).Min(0).Nullable(),
+		"notes":  (&StringValidator{}).Optional().(*StringValidator).Nullable(),
+	}}
+
+	if result := object.Validate(map[string]any{"rating": nil}); !result.IsValid {
+		t.Errorf("Expected null rating and missing notes to be valid, got %+v", result.Errors)
+	}
+	if result := object.Validate(map[string]any{"rating": -1.0, "notes": nil}); result.IsValid || result.Errors[0].Code != CodeNumberMin {
+		t.Errorf("Expected inner min error, got %+v", result.Errors)
+	}
+	result := object.Validate(map[string]any{"notes": "hi"})
+	if result.IsValid || result.Errors[0].Code != CodeObjectMissingField {
+		t.Errorf("Expected missing rating error, got %+v", result.Errors)
+	}
+}
@@ -1,17 +1,76 @@
 package validation
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"reflect"
 )
 
-// NumberValidator validates numeric values
+// NumberValidator validates numeric values: any Go numeric type,
+// json.Number and big.Int, big.Float and big.Rat values. Constraints are
+// checked exactly, without converting large int64/uint64 values or decimal
+// strings to float64
 type NumberValidator struct {
 	BaseValidator
-	min *float64
-	max *float64
+	min          *float64
+	max          *float64
+	exclusiveMin *float64
+	exclusiveMax *float64
+	multipleOf   *float64
+	maxDecimals  *int
+	integer      bool
+	positive     bool
+	negative     bool
+	finite       bool
+	coerce       bool
+}
+
+// NumberConstraints describes the constraints configured on a NumberValidator
+type NumberConstraints struct {
+	Min          *float64
+	Max          *float64
+	ExclusiveMin *float64
+	ExclusiveMax *float64
+	MultipleOf   *float64
+	MaxDecimals  *int
+	Integer      bool
+	Positive     bool
+	Negative     bool
+	Finite       bool
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (n *NumberValidator) Constraints() NumberConstraints {
+	return NumberConstraints{
+		Min:          copyPointer(n.min),
+		Max:          copyPointer(n.max),
+		ExclusiveMin: copyPointer(n.exclusiveMin),
+		ExclusiveMax: copyPointer(n.exclusiveMax),
+		MultipleOf:   copyPointer(n.multipleOf),
+		MaxDecimals:  copyPointer(n.maxDecimals),
+		Integer:      n.integer,
+		Positive:     n.positive,
+		Negative:     n.negative,
+		Finite:       n.finite,
+	}
 }
 
 func (n *NumberValidator) Validate(value any) ValidationResult {
+	return n.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (n *NumberValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return n.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (n *NumberValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = n.applyDefault(value)
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if n.isOptional() {
@@ -20,99 +79,277 @@ func (n *NumberValidator) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: n.getMessage("Number value is required"),
-				},
+				n.newError(opts, CodeNumberRequired, nil),
 			},
 		}
 	}
 
-	// Convert value to float64 for validation
-	var numValue float64
-	switch v := value.(type) {
-	case float64:
-		numValue = v
-	case float32:
-		numValue = float64(v)
-	case int:
-		numValue = float64(v)
-	case int8:
-		numValue = float64(v)
-	case int16:
-		numValue = float64(v)
-	case int32:
-		numValue = float64(v)
-	case int64:
-		numValue = float64(v)
-	case uint:
-		numValue = float64(v)
-	case uint8:
-		numValue = float64(v)
-	case uint16:
-		numValue = float64(v)
-	case uint32:
-		numValue = float64(v)
-	case uint64:
-		numValue = float64(v)
-	default:
+	// Parse numeric strings when coercion is enabled
+	if n.coerce {
+		value = coerceNumber(value)
+	}
+
+	// Convert value to an exact number for validation
+	num, ok := toNumber(value)
+	if !ok {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: n.getMessage(fmt.Sprintf("Expected numeric value, got %T", value)),
-				},
+				n.newError(opts, CodeNumberType,
+					map[string]any{"expected": "number", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
 
-	// Check min constraint
-	if n.min != nil && numValue < *n.min {
-		return ValidationResult{
-			IsValid: false,
-			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: n.getMessage(fmt.Sprintf("Number must be at least %f", *n.min)),
-				},
-			},
+	// check records a failed constraint and reports whether to stop
+	var errors []ValidationError
+	check := func(failed bool, code string, params map[string]any) bool {
+		if failed {
+			errors = append(errors, n.newError(opts, code, params))
 		}
+		return n.shouldAbort(errors)
 	}
+	actual := num.float()
 
-	// Check max constraint
-	if n.max != nil && numValue > *n.max {
-		return ValidationResult{
-			IsValid: false,
-			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: n.getMessage(fmt.Sprintf("Number must be at most %f", *n.max)),
-				},
-			},
+	// NaN and infinities only fail Finite and Int; NaN passes the bounds
+	// like it does in float64 comparisons
+	if n.finite && check(!num.isFinite(), CodeNumberFinite, map[string]any{"actual": actual}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	if n.integer && check(!num.isInt(), CodeNumberInt, map[string]any{"actual": actual}) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+
+	if !num.nan {
+		// Check the sign and the bounds
+		if n.positive && check(num.sign() <= 0, CodeNumberPositive, map[string]any{"actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.negative && check(num.sign() >= 0, CodeNumberNegative, map[string]any{"actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.min != nil && check(num.cmp(floatNumber(*n.min, 64)) < 0, CodeNumberMin,
+			map[string]any{"min": *n.min, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.max != nil && check(num.cmp(floatNumber(*n.max, 64)) > 0, CodeNumberMax,
+			map[string]any{"max": *n.max, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.exclusiveMin != nil && check(num.cmp(floatNumber(*n.exclusiveMin, 64)) <= 0, CodeNumberExclusiveMin,
+			map[string]any{"min": *n.exclusiveMin, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.exclusiveMax != nil && check(num.cmp(floatNumber(*n.exclusiveMax, 64)) >= 0, CodeNumberExclusiveMax,
+			map[string]any{"max": *n.exclusiveMax, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+	}
+
+	if num.isFinite() {
+		// Check the step and the precision
+		if n.multipleOf != nil && check(!num.isMultipleOf(floatNumber(*n.multipleOf, 64)), CodeNumberMultipleOf,
+			map[string]any{"step": *n.multipleOf, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
+		}
+		if n.maxDecimals != nil && check(!num.hasAtMostDecimals(*n.maxDecimals), CodeNumberDecimals,
+			map[string]any{"decimals": *n.maxDecimals, "actual": actual}) {
+			return ValidationResult{IsValid: false, Errors: errors}
 		}
 	}
 
-	// Value is valid
-	return ValidationResult{IsValid: true, Errors: nil}
+	// Run custom checks, then transforms
+	errors = n.refine(value, errors, opts)
+	return n.finish(value, errors, opts)
 }
 
 func (n *NumberValidator) Min(min float64) *NumberValidator {
+	n = clone(n)
 	n.min = &min
 	return n
 }
 
 func (n *NumberValidator) Max(max float64) *NumberValidator {
+	n = clone(n)
 	n.max = &max
 	return n
 }
 
+// ExclusiveMin requires the number to be strictly greater than min
+func (n *NumberValidator) ExclusiveMin(min float64) *NumberValidator {
+	n = clone(n)
+	n.exclusiveMin = &min
+	return n
+}
+
+// ExclusiveMax requires the number to be strictly less than max
+func (n *NumberValidator) ExclusiveMax(max float64) *NumberValidator {
+	n = clone(n)
+	n.exclusiveMax = &max
+	return n
+}
+
+// Int requires the number to be an integer; 3.0 is accepted
+func (n *NumberValidator) Int() *NumberValidator {
+	n = clone(n)
+	n.integer = true
+	return n
+}
+
+// Positive requires the number to be greater than zero
+func (n *NumberValidator) Positive() *NumberValidator {
+	n = clone(n)
+	n.positive = true
+	return n
+}
+
+// Negative requires the number to be less than zero
+func (n *NumberValidator) Negative() *NumberValidator {
+	n = clone(n)
+	n.negative = true
+	return n
+}
+
+// MultipleOf requires the number to be an integer multiple of step, which
+// must be positive. Decimal steps are exact, so 0.3 is a multiple of 0.1
+func (n *NumberValidator) MultipleOf(step float64) *NumberValidator {
+	n = clone(n)
+	if !(step > 0) || math.IsInf(step, 1) {
+		panic(fmt.Sprintf("validation: MultipleOf step must be a positive finite number, got %v", step))
+	}
+	n.multipleOf = &step
+	return n
+}
+
+// Finite rejects NaN and infinities
+func (n *NumberValidator) Finite() *NumberValidator {
+	n = clone(n)
+	n.finite = true
+	return n
+}
+
+// MaxDecimals limits the number of decimal places, e.g. 2 for currency
+// amounts
+func (n *NumberValidator) MaxDecimals(places int) *NumberValidator {
+	n = clone(n)
+	n.maxDecimals = &places
+	return n
+}
+
+// Refine adds a custom check that receives the number, in its original Go
+// type, once it passed the type check and returns an error, or nil when the
+// value is acceptable
+func (n *NumberValidator) Refine(check func(value any) *ValidationError) *NumberValidator {
+	n = clone(n)
+	n.addRefinement(check)
+	return n
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (n *NumberValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *NumberValidator {
+	n = clone(n)
+	n.addContextRefinement(check)
+	return n
+}
+
+// Coerce turns numeric strings such as "42" or "3.5" into a json.Number
+// before validation, e.g. for query string and CSV input. Only JSON number
+// syntax is accepted, so "NaN", "Inf" and "0x10" stay invalid
+func (n *NumberValidator) Coerce() *NumberValidator {
+	n = clone(n)
+	n.coerce = true
+	return n
+}
+
+// Transform adds a function that turns the valid value into the output
+func (n *NumberValidator) Transform(transform func(value any) (any, error)) *NumberValidator {
+	n = clone(n)
+	n.addTransform(transform)
+	return n
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (n *NumberValidator) Default(value float64) *NumberValidator {
+	n = clone(n)
+	n.setDefault(value)
+	return n
+}
+
+// AbortEarly stops validation at the first failed constraint
+func (n *NumberValidator) AbortEarly() *NumberValidator {
+	n = clone(n)
+	n.setAbortEarly(true)
+	return n
+}
+
+// CollectAll reports every failed constraint (the default)
+func (n *NumberValidator) CollectAll() *NumberValidator {
+	n = clone(n)
+	n.setAbortEarly(false)
+	return n
+}
+
 func (n *NumberValidator) Optional() Validator[float64] {
+	n = clone(n)
 	n.setOptional()
 	return n
 }
 
+// Nullable also accepts null, see NullableValidator
+func (n *NumberValidator) Nullable() *NullableValidator {
+	return nullableOf(n, n.optional)
+}
+
 func (n *NumberValidator) WithMessage(message string) Validator[float64] {
+	n = clone(n)
 	n.setMessage(message)
 	return n
 }
+
+// toFloat64 converts any Go numeric type, json.Number and big numbers to
+// float64
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+
+	// Named numeric types such as `type Age int`
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflected.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(reflected.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return reflected.Float(), true
+	}
+
+	// json.Number and big numbers
+	if num, ok := toNumber(value); ok {
+		return num.float(), true
+	}
+	return 0, false
+}
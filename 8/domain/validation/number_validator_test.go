@@ -1,6 +1,9 @@
 package validation
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -88,7 +91,7 @@ func TestNumberValidator_ValidateNilOptional(t *testing.T) {
 	validator := &NumberValidator{}
 
 	// Test with nil value (optional)
-	validator.Optional()
+	validator = validator.Optional().(*NumberValidator)
 	result := validator.Validate(nil)
 	if !result.IsValid {
 		t.Error("Number validator should accept nil value when optional")
@@ -102,7 +105,7 @@ func TestNumberValidator_MinConstraint(t *testing.T) {
 	validator := &NumberValidator{}
 	minValue := 10.0
 
-	validator.Min(minValue)
+	validator = validator.Min(minValue)
 
 	// Test with value below min
 	result := validator.Validate(5.0)
@@ -133,7 +136,7 @@ func TestNumberValidator_MaxConstraint(t *testing.T) {
 	validator := &NumberValidator{}
 	maxValue := 100.0
 
-	validator.Max(maxValue)
+	validator = validator.Max(maxValue)
 
 	// Test with value above max
 	result := validator.Validate(150.0)
@@ -165,7 +168,7 @@ func TestNumberValidator_MinMaxConstraints(t *testing.T) {
 	minValue := 10.0
 	maxValue := 100.0
 
-	validator.Min(minValue).Max(maxValue)
+	validator = validator.Min(minValue).Max(maxValue)
 
 	// Test with value below min
 	result := validator.Validate(5.0)
@@ -202,7 +205,7 @@ func TestNumberValidator_Min(t *testing.T) {
 	validator := &NumberValidator{}
 	minValue := 10.5
 
-	validator.Min(minValue)
+	validator = validator.Min(minValue)
 
 	if validator.min == nil {
 		t.Error("Min should set the min field")
@@ -216,7 +219,7 @@ func TestNumberValidator_Max(t *testing.T) {
 	validator := &NumberValidator{}
 	maxValue := 100.0
 
-	validator.Max(maxValue)
+	validator = validator.Max(maxValue)
 
 	if validator.max == nil {
 		t.Error("Max should set the max field")
@@ -231,13 +234,17 @@ func TestNumberValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
+	}
+	copied := result.(*NumberValidator)
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -248,13 +255,17 @@ func TestNumberValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
 	}
+	copied := result.(*NumberValidator)
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -263,7 +274,7 @@ func TestNumberValidator_WithMessageValidation(t *testing.T) {
 	validator := &NumberValidator{}
 	customMessage := "Custom number validation message"
 
-	validator.WithMessage(customMessage)
+	validator = validator.WithMessage(customMessage).(*NumberValidator)
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate("not a number")
@@ -288,22 +299,26 @@ func TestNumberValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
 	}
+	copied := result.(*NumberValidator)
 
 	// Should have all properties set
-	if validator.min == nil || *validator.min != 0.0 {
+	if copied.min == nil || *copied.min != 0.0 {
 		t.Error("Min should be set to 0.0")
 	}
-	if validator.max == nil || *validator.max != 100.0 {
+	if copied.max == nil || *copied.max != 100.0 {
 		t.Error("Max should be set to 100.0")
 	}
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -312,8 +327,8 @@ func TestNumberValidator_ZeroValues(t *testing.T) {
 	validator := &NumberValidator{}
 
 	// Test with zero values
-	validator.Min(0.0)
-	validator.Max(0.0)
+	validator = validator.Min(0.0)
+	validator = validator.Max(0.0)
 
 	if validator.min == nil || *validator.min != 0.0 {
 		t.Error("Min should accept zero value")
@@ -343,8 +358,8 @@ func TestNumberValidator_NegativeValues(t *testing.T) {
 	validator := &NumberValidator{}
 
 	// Test with negative values
-	validator.Min(-100.0)
-	validator.Max(-1.0)
+	validator = validator.Min(-100.0)
+	validator = validator.Max(-1.0)
 
 	if validator.min == nil || *validator.min != -100.0 {
 		t.Error("Min should accept negative value")
@@ -430,7 +445,7 @@ func TestNumberValidator_ErrorMessages(t *testing.T) {
 	}
 
 	// Test min constraint error message
-	validator.Min(10.0)
+	validator = validator.Min(10.0)
 	result = validator.Validate(5.0)
 	if result.IsValid {
 		t.Error("Number validator should reject value below min")
@@ -445,7 +460,7 @@ func TestNumberValidator_ErrorMessages(t *testing.T) {
 
 	// Test max constraint error message
 	validator = &NumberValidator{}
-	validator.Max(100.0)
+	validator = validator.Max(100.0)
 	result = validator.Validate(150.0)
 	if result.IsValid {
 		t.Error("Number validator should reject value above max")
@@ -461,7 +476,7 @@ func TestNumberValidator_ErrorMessages(t *testing.T) {
 
 func TestNumberValidator_DifferentNumericTypes(t *testing.T) {
 	validator := &NumberValidator{}
-	validator.Min(10.0).Max(100.0)
+	validator = validator.Min(10.0).Max(100.0)
 
 	// Test with different numeric types within range
 	testCases := []interface{}{
@@ -509,3 +524,213 @@ func TestNumberValidator_DifferentNumericTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestNumberValidator_CollectsAllErrors(t *testing.T) {
+	// Contradictory bounds make every value fail both checks
+	validator := &NumberValidator{}
+	validator = validator.Min(10).Max(5)
+
+	result := validator.Validate(7)
+	if result.IsValid {
+		t.Error("Number validator should reject value failing both bounds")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %d: %+v", len(result.Errors), result.Errors)
+	}
+}
+
+func TestNumberValidator_AbortEarly(t *testing.T) {
+	validator := &NumberValidator{}
+	validator = validator.Min(10).Max(5).AbortEarly()
+
+	result := validator.Validate(7)
+	if result.IsValid {
+		t.Error("Number validator should reject value failing both bounds")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error in abort-early mode, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Message != "Number must be at least 10.000000" {
+		t.Errorf("Expected min error first, got '%s'", result.Errors[0].Message)
+	}
+}
+
+func TestNumberValidator_Constraints(t *testing.T) {
+	validator := (&NumberValidator{}).Min(1)
+
+	constraints := validator.Constraints()
+	if constraints.Min == nil || *constraints.Min != 1 {
+		t.Error("Constraints should expose min")
+	}
+	if constraints.Max != nil {
+		t.Error("Constraints should leave unset max nil")
+	}
+}
+
+type namedInt int
+
+func TestNumberValidator_NamedNumericType(t *testing.T) {
+	validator := (&NumberValidator{}).Max(10)
+
+	if result := validator.Validate(namedInt(5)); !result.IsValid {
+		t.Error("Number validator should accept named numeric types")
+	}
+	if result := validator.Validate(namedInt(50)); result.IsValid {
+		t.Error("Number validator should apply constraints to named numeric types")
+	}
+}
+
+func TestNumberValidator_Coerce(t *testing.T) {
+	validator := (&NumberValidator{}).Coerce().Min(0)
+
+	result := validator.Validate(" 42.5 ")
+	if !result.IsValid || result.Value != json.Number("42.5") {
+		t.Errorf("Expected numeric string to coerce to 42.5, got %+v", result)
+	}
+	// Large integers keep every digit
+	if result := validator.Validate("9007199254740993"); !result.IsValid || result.Value != json.Number("9007199254740993") {
+		t.Errorf("Expected large integer to coerce exactly, got %+v", result)
+	}
+	if result := validator.Max(9007199254740992).Validate("9007199254740993"); result.IsValid {
+		t.Error("Expected coerced large integer to be compared exactly")
+	}
+	if result := validator.Validate("-1"); result.IsValid || result.Errors[0].Code != CodeNumberMin {
+		t.Errorf("Expected coerced value to be checked against min, got %+v", result.Errors)
+	}
+	for _, value := range []string{"abc", "NaN", "Inf", "-Infinity", "0x10", "1_000", "+1", ".5"} {
+		if result := validator.Validate(value); result.IsValid || result.Errors[0].Code != CodeNumberType {
+			t.Errorf("Expected type error for %q, got %+v", value, result.Errors)
+		}
+	}
+
+	// Without Coerce strings are still rejected
+	if result := (&NumberValidator{}).Validate("42"); result.IsValid {
+		t.Error("Number validator should reject strings without Coerce")
+	}
+}
+
+func TestNumberValidator_DefaultAndTransform(t *testing.T) {
+	validator := (&NumberValidator{}).Default(10).Transform(func(value any) (any, error) {
+		number, _ := toFloat64(value)
+		return int(number), nil
+	})
+
+	if result := validator.Validate(nil); !result.IsValid || result.Value != 10 {
+		t.Errorf("Expected transformed default output, got %+v", result)
+	}
+	if result := validator.Validate(7.0); result.Value != 7 {
+		t.Errorf("Expected transformed output, got %+v", result)
+	}
+}
+
+func TestNumberValidator_IntAndSign(t *testing.T) {
+	validator := (&NumberValidator{}).Int().Positive()
+
+	for _, value := range []any{1, 3.0, int64(math.MaxInt64), uint64(math.MaxUint64)} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v (%T) to be a positive integer, got %+v", value, value, result.Errors)
+		}
+	}
+	result := validator.Validate(-1.5)
+	if len(result.Errors) != 2 || result.Errors[0].Code != CodeNumberInt || result.Errors[1].Code != CodeNumberPositive {
+		t.Errorf("Expected int and positive errors, got %+v", result.Errors)
+	}
+	if result := validator.Validate(0); result.IsValid || result.Errors[0].Code != CodeNumberPositive {
+		t.Errorf("Expected zero to fail Positive, got %+v", result.Errors)
+	}
+	if result := (&NumberValidator{}).Negative().Validate(0); result.IsValid || result.Errors[0].Code != CodeNumberNegative {
+		t.Errorf("Expected zero to fail Negative, got %+v", result.Errors)
+	}
+}
+
+func TestNumberValidator_ExclusiveBounds(t *testing.T) {
+	validator := (&NumberValidator{}).ExclusiveMin(0).ExclusiveMax(1)
+
+	if result := validator.Validate(0.5); !result.IsValid {
+		t.Errorf("Expected 0.5 to be within (0, 1), got %+v", result.Errors)
+	}
+	if result := validator.Validate(0); result.IsValid || result.Errors[0].Code != CodeNumberExclusiveMin {
+		t.Errorf("Expected exclusive min error, got %+v", result.Errors)
+	}
+	result := validator.Validate(1)
+	if result.IsValid || result.Errors[0].Message != "Number must be less than 1" {
+		t.Errorf("Expected exclusive max error, got %+v", result.Errors)
+	}
+}
+
+func TestNumberValidator_MultipleOfAndDecimals(t *testing.T) {
+	// Decimal steps are exact: 0.3 is not a multiple of 0.1 in float64
+	cents := (&NumberValidator{}).MultipleOf(0.01)
+	for _, value := range []any{0.3, 19.99, 1e6, json.Number("12.34")} {
+		if result := cents.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be a multiple of 0.01, got %+v", value, result.Errors)
+		}
+	}
+	if result := cents.Validate(0.015); result.IsValid || result.Errors[0].Code != CodeNumberMultipleOf {
+		t.Errorf("Expected multiple_of error, got %+v", result.Errors)
+	}
+
+	price := (&NumberValidator{}).MaxDecimals(2)
+	if result := price.Validate(json.Number("10.50")); !result.IsValid {
+		t.Errorf("Expected 2 decimals to be accepted, got %+v", result.Errors)
+	}
+	result := price.Validate(10.505)
+	if result.IsValid || result.Errors[0].Message != "Number must have at most 2 decimal places" {
+		t.Errorf("Expected decimals error, got %+v", result.Errors)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MultipleOf should panic for a non-positive step")
+		}
+	}()
+	(&NumberValidator{}).MultipleOf(0)
+}
+
+func TestNumberValidator_Finite(t *testing.T) {
+	// Without Finite NaN and infinities are only caught by the bounds
+	bounded := (&NumberValidator{}).Max(10)
+	if result := bounded.Validate(math.Inf(1)); result.IsValid || result.Errors[0].Code != CodeNumberMax {
+		t.Errorf("Expected +Inf to exceed max, got %+v", result.Errors)
+	}
+	if result := bounded.Validate(math.NaN()); !result.IsValid {
+		t.Errorf("Expected NaN to pass bounds without Finite, got %+v", result.Errors)
+	}
+
+	finite := (&NumberValidator{}).Finite()
+	for _, value := range []any{math.NaN(), math.Inf(-1), float32(math.Inf(1)), new(big.Float).SetInf(false)} {
+		if result := finite.Validate(value); result.IsValid || result.Errors[0].Code != CodeNumberFinite {
+			t.Errorf("Expected finite error for %v, got %+v", value, result.Errors)
+		}
+	}
+}
+
+func TestNumberValidator_ExactTypes(t *testing.T) {
+	// 2^53 + 1 cannot be represented as a float64
+	validator := (&NumberValidator{}).Max(9007199254740992)
+	if result := validator.Validate(int64(9007199254740993)); result.IsValid || result.Errors[0].Code != CodeNumberMax {
+		t.Errorf("Expected int64 above max to be rejected exactly, got %+v", result.Errors)
+	}
+	if result := validator.Validate(json.Number("9007199254740993")); result.IsValid {
+		t.Error("Expected json.Number above max to be rejected exactly")
+	}
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	ids := (&NumberValidator{}).Int().Positive()
+	for _, value := range []any{huge, *huge, json.Number("123456789012345678901234567890"), big.NewRat(4, 2)} {
+		if result := ids.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v (%T) to be a positive integer, got %+v", value, value, result.Errors)
+		}
+	}
+	if result := ids.Validate(big.NewFloat(1.5)); result.IsValid || result.Errors[0].Code != CodeNumberInt {
+		t.Errorf("Expected big.Float 1.5 to fail Int, got %+v", result.Errors)
+	}
+
+	// Malformed json.Number and huge exponents
+	if result := ids.Validate(json.Number("12abc")); result.IsValid || result.Errors[0].Code != CodeNumberType {
+		t.Errorf("Expected type error for malformed json.Number, got %+v", result.Errors)
+	}
+	if result := (&NumberValidator{}).Finite().Validate(json.Number("1e999999999")); result.IsValid {
+		t.Error("Expected huge exponent to overflow to infinity")
+	}
+}
@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+// number is an exact representation of a numeric value. Finite values are
+// held as a big.Rat so that large int64/uint64 values, json.Number and big
+// numbers are compared without float64 rounding
+type number struct {
+	rat *big.Rat // nil for NaN and infinities
+	nan bool
+	inf int // +1 or -1 for infinities
+}
+
+// maxExactExponent bounds the exponent of decimal strings parsed exactly;
+// larger ones are read as float64 so that input like "1e999999999" cannot
+// allocate huge integers
+const maxExactExponent = 1000
+
+var jsonNumberRegex = regexp.MustCompile(`^-?(0|[1-9]\d*)(\.\d+)?([eE][+-]?(\d+))?$`)
+
+// toNumber converts Go numeric types, json.Number and big.Int, big.Float and
+// big.Rat values (or pointers to them) into a number
+func toNumber(value any) (number, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		return parseDecimal(string(v))
+	case *big.Int:
+		if v == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).SetInt(v)}, true
+	case big.Int:
+		return number{rat: new(big.Rat).SetInt(&v)}, true
+	case *big.Float:
+		if v == nil {
+			return number{}, false
+		}
+		return bigFloatNumber(v), true
+	case big.Float:
+		return bigFloatNumber(&v), true
+	case *big.Rat:
+		if v == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).Set(v)}, true
+	case big.Rat:
+		return number{rat: new(big.Rat).Set(&v)}, true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{rat: new(big.Rat).SetInt64(reflected.Int())}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{rat: new(big.Rat).SetInt(new(big.Int).SetUint64(reflected.Uint()))}, true
+	case reflect.Float32:
+		return floatNumber(reflected.Float(), 32), true
+	case reflect.Float64:
+		return floatNumber(reflected.Float(), 64), true
+	}
+	return number{}, false
+}
+
+// floatNumber converts a float through its shortest decimal form, so that
+// 0.1 is exactly one tenth rather than the nearest binary fraction
+func floatNumber(f float64, bitSize int) number {
+	switch {
+	case math.IsNaN(f):
+		return number{nan: true}
+	case math.IsInf(f, 1):
+		return number{inf: 1}
+	case math.IsInf(f, -1):
+		return number{inf: -1}
+	}
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	return number{rat: rat}
+}
+
+func bigFloatNumber(f *big.Float) number {
+	if f.IsInf() {
+		return number{inf: f.Sign()}
+	}
+	rat, _ := f.Rat(nil)
+	return number{rat: rat}
+}
+
+// parseDecimal parses a number in JSON syntax
+func parseDecimal(s string) (number, bool) {
+	match := jsonNumberRegex.FindStringSubmatch(s)
+	if match == nil {
+		return number{}, false
+	}
+	if match[4] != "" {
+		if exponent, err := strconv.Atoi(match[4]); err != nil || exponent > maxExactExponent {
+			f, _ := strconv.ParseFloat(s, 64)
+			return floatNumber(f, 64), true
+		}
+	}
+	rat, ok := new(big.Rat).SetString(s)
+	return number{rat: rat}, ok
+}
+
+func (n number) isFinite() bool {
+	return n.rat != nil
+}
+
+func (n number) isInt() bool {
+	return n.rat != nil && n.rat.IsInt()
+}
+
+// cmp compares two non-NaN numbers, returning -1, 0 or +1
+func (n number) cmp(other number) int {
+	if n.inf != 0 || other.inf != 0 {
+		switch {
+		case n.inf == other.inf:
+			return 0
+		case n.inf > other.inf:
+			return 1
+		default:
+			return -1
+		}
+	}
+	return n.rat.Cmp(other.rat)
+}
+
+// sign returns -1, 0 or +1 for non-NaN numbers
+func (n number) sign() int {
+	if n.inf != 0 {
+		return n.inf
+	}
+	return n.rat.Sign()
+}
+
+// isMultipleOf reports whether a finite number is an integer multiple of
+// the positive step
+func (n number) isMultipleOf(step number) bool {
+	return new(big.Rat).Quo(n.rat, step.rat).IsInt()
+}
+
+// hasAtMostDecimals reports whether a finite number has no more than the
+// given number of decimal places
+func (n number) hasAtMostDecimals(places int) bool {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	return new(big.Rat).Mul(n.rat, new(big.Rat).SetInt(scale)).IsInt()
+}
+
+// float returns the nearest float64, e.g. for error params
+func (n number) float() float64 {
+	switch {
+	case n.nan:
+		return math.NaN()
+	case n.inf != 0:
+		return math.Inf(n.inf)
+	}
+	f, _ := n.rat.Float64()
+	return f
+}
@@ -0,0 +1,170 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ObjectSchema is implemented by every ObjectValidator instantiation, so
+// that Merge accepts objects decoding into other types
+type ObjectSchema interface {
+	AnyValidator
+	Fields() map[string]AnyValidator
+	FieldNames() []string
+	UnknownKeyPolicy() UnknownKeys
+	AllowsUnknown() bool
+	CatchallValidator() AnyValidator
+	Conditions() []Condition
+	Dependencies() ObjectDependencies
+}
+
+// Composition helpers return a new validator and leave the receiver
+// unchanged. The new validator keeps the receiver's optionality, message,
+// abort-early mode, field order, unknown-key policy, conditions,
+// dependencies and field count and name constraints; refinements,
+// transforms and the default describe the original shape and are not
+// carried over
+
+// Partial returns a copy of the object in which every field is optional,
+// e.g. to validate PATCH bodies with the schema used for PUT
+func (o *ObjectValidator[T]) Partial() *ObjectValidator[T] {
+	fields := make(map[string]AnyValidator, len(o.Schema))
+	for name, field := range o.Schema {
+		fields[name] = withOptional(field, true)
+	}
+	return o.derive(fields, o.order)
+}
+
+// Required returns a copy of the object in which no field is optional.
+// Fields with a default still accept a missing value
+func (o *ObjectValidator[T]) Required() *ObjectValidator[T] {
+	fields := make(map[string]AnyValidator, len(o.Schema))
+	for name, field := range o.Schema {
+		fields[name] = withOptional(field, false)
+	}
+	return o.derive(fields, o.order)
+}
+
+// Pick returns a copy of the object with only the given fields, in the
+// given order. It panics on fields the schema does not declare
+func (o *ObjectValidator[T]) Pick(keys ...string) *ObjectValidator[T] {
+	fields := make(map[string]AnyValidator, len(keys))
+	for _, key := range keys {
+		field, ok := o.Schema[key]
+		if !ok {
+			panic(fmt.Sprintf("validation: Pick of undeclared field %q", key))
+		}
+		fields[key] = field
+	}
+	return o.derive(fields, keys)
+}
+
+// Omit returns a copy of the object without the given fields. It panics on
+// fields the schema does not declare
+func (o *ObjectValidator[T]) Omit(keys ...string) *ObjectValidator[T] {
+	fields := o.copyFields()
+	for _, key := range keys {
+		if _, ok := fields[key]; !ok {
+			panic(fmt.Sprintf("validation: Omit of undeclared field %q", key))
+		}
+		delete(fields, key)
+	}
+	return o.derive(fields, o.order)
+}
+
+// Extend returns a copy of the object with additional fields, which come
+// after the existing ones in sorted order; fields that are already declared
+// are replaced in place
+func (o *ObjectValidator[T]) Extend(fields map[string]AnyValidator) *ObjectValidator[T] {
+	return o.extend(fields, sortedKeys(fields))
+}
+
+// Merge returns a copy of the object with the fields of other, which replace
+// fields of the same name. The unknown-key policy and catchall of other
+// apply to the result. The conditions and dependencies of both objects
+// apply, except that a dependent schema of other replaces one on the same
+// field
+func (o *ObjectValidator[T]) Merge(other ObjectSchema) *ObjectValidator[T] {
+	merged := o.extend(other.Fields(), other.FieldNames())
+	merged.unknownKeys = other.UnknownKeyPolicy()
+	merged.strict = !other.AllowsUnknown()
+	merged.catchall = other.CatchallValidator()
+	merged.conditions = appendCopy(merged.conditions, other.Conditions()...)
+	dependencies := other.Dependencies()
+	if dependencies.Required != nil {
+		merged = merged.DependentRequired(dependencies.Required)
+	}
+	if dependencies.Schemas != nil {
+		merged = merged.DependentSchemas(dependencies.Schemas)
+	}
+	return merged
+}
+
+// extend adds fields after the existing ones, in the order of names
+func (o *ObjectValidator[T]) extend(fields map[string]AnyValidator, names []string) *ObjectValidator[T] {
+	extended := o.copyFields()
+	order := o.FieldNames()
+	for _, name := range names {
+		if _, declared := extended[name]; !declared {
+			order = append(order, name)
+		}
+		extended[name] = fields[name]
+	}
+	return o.derive(extended, order)
+}
+
+func (o *ObjectValidator[T]) copyFields() map[string]AnyValidator {
+	fields := make(map[string]AnyValidator, len(o.Schema))
+	for name, field := range o.Schema {
+		fields[name] = field
+	}
+	return fields
+}
+
+// derive builds the validator returned by the composition helpers
+func (o *ObjectValidator[T]) derive(fields map[string]AnyValidator, order []string) *ObjectValidator[T] {
+	derived := &ObjectValidator[T]{
+		BaseValidator: BaseValidator{
+			optional:   o.optional,
+			message:    o.message,
+			abortEarly: o.abortEarly,
+		},
+		Schema:      fields,
+		order:       append([]string(nil), order...),
+		unknownKeys: o.unknownKeys,
+		strict:      o.strict,
+		catchall:    o.catchall,
+
+		minProperties: o.minProperties,
+		maxProperties: o.maxProperties,
+		propertyNames: o.propertyNames,
+	}
+	derived.conditions = o.Conditions()
+	dependencies := o.Dependencies()
+	derived.dependentRequired = dependencies.Required
+	derived.dependentSchemas = dependencies.Schemas
+	return derived
+}
+
+// withOptional returns a copy of a built-in validator with its optional
+// flag set, leaving the original untouched. Other validators are wrapped so
+// that they accept or reject a missing value
+func withOptional(validator AnyValidator, optional bool) AnyValidator {
+	if current, ok := validator.(interface{ isOptional() bool }); ok && current.isOptional() == optional {
+		return validator
+	}
+
+	original := reflect.ValueOf(validator)
+	if original.Kind() == reflect.Pointer && original.Elem().Kind() == reflect.Struct {
+		clone := reflect.New(original.Elem().Type())
+		clone.Elem().Set(original.Elem())
+		if base, ok := clone.Interface().(interface{ setOptionalTo(bool) }); ok {
+			base.setOptionalTo(optional)
+			return clone.Interface().(AnyValidator)
+		}
+	}
+	if optional {
+		return &NullableValidator{BaseValidator: BaseValidator{optional: true}, Inner: validator}
+	}
+	return validator
+}
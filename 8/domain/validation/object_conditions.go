@@ -0,0 +1,185 @@
+package validation
+
+import "sort"
+
+// Condition is a conditional rule of an object, like JSON Schema
+// if/then/else: objects accepted by If must also match Then, and all other
+// objects must match Else. Then and Else may be nil
+type Condition struct {
+	If   AnyValidator
+	Then AnyValidator
+	Else AnyValidator
+}
+
+// ObjectDependencies holds the rules that apply when a field is present,
+// like JSON Schema dependentRequired and dependentSchemas
+type ObjectDependencies struct {
+	// Required lists, per field, the fields that must be present with it
+	Required map[string][]string
+	// Schemas holds, per field, a validator for the whole object
+	Schemas map[string]AnyValidator
+}
+
+// ObjectCondition is returned by If and When; Then or ThenElse complete the
+// rule
+type ObjectCondition[T any] struct {
+	object    *ObjectValidator[T]
+	condition AnyValidator
+}
+
+// If starts a conditional rule on the whole object. The condition usually is
+// an object validator with Passthrough, so that it only looks at the fields
+// it declares
+func (o *ObjectValidator[T]) If(condition AnyValidator) *ObjectCondition[T] {
+	return &ObjectCondition[T]{object: o, condition: condition}
+}
+
+// When starts a conditional rule that applies when field is present and
+// accepted by predicate, e.g. When("delivery", Literal("ship")). An
+// optional predicate also applies when the field is missing
+func (o *ObjectValidator[T]) When(field string, predicate AnyValidator) *ObjectCondition[T] {
+	return o.If(&ObjectValidator[map[string]any]{
+		Schema:      map[string]AnyValidator{field: predicate},
+		unknownKeys: UnknownKeysPassthrough,
+	})
+}
+
+// Then sets the validator that objects matching the condition must also
+// match. Like the condition, it validates the whole object, so object
+// validators used here usually call Passthrough
+func (c *ObjectCondition[T]) Then(then AnyValidator) *ObjectValidator[T] {
+	return c.ThenElse(then, nil)
+}
+
+// ThenElse sets the validators for objects that match the condition and for
+// those that do not; either may be nil
+func (c *ObjectCondition[T]) ThenElse(then AnyValidator, otherwise AnyValidator) *ObjectValidator[T] {
+	o := clone(c.object)
+	o.conditions = appendCopy(o.conditions, Condition{If: c.condition, Then: then, Else: otherwise})
+	return o
+}
+
+// DependentRequired requires, whenever a field in dependencies is present,
+// the fields listed for it, e.g. {"card_number": {"cvv"}}
+func (o *ObjectValidator[T]) DependentRequired(dependencies map[string][]string) *ObjectValidator[T] {
+	o = clone(o)
+	o.dependentRequired = o.Dependencies().Required
+	if o.dependentRequired == nil {
+		o.dependentRequired = make(map[string][]string, len(dependencies))
+	}
+	for field, required := range dependencies {
+		o.dependentRequired[field] = append(o.dependentRequired[field], required...)
+	}
+	return o
+}
+
+// DependentSchemas validates the whole object with the validator of each
+// field in dependencies that is present
+func (o *ObjectValidator[T]) DependentSchemas(dependencies map[string]AnyValidator) *ObjectValidator[T] {
+	o = clone(o)
+	o.dependentSchemas = o.Dependencies().Schemas
+	if o.dependentSchemas == nil {
+		o.dependentSchemas = make(map[string]AnyValidator, len(dependencies))
+	}
+	for field, validator := range dependencies {
+		o.dependentSchemas[field] = validator
+	}
+	return o
+}
+
+// Conditions returns the conditional rules in the order they were added
+func (o *ObjectValidator[T]) Conditions() []Condition {
+	return append([]Condition(nil), o.conditions...)
+}
+
+// Dependencies returns a copy of the rules set by DependentRequired and
+// DependentSchemas
+func (o *ObjectValidator[T]) Dependencies() ObjectDependencies {
+	dependencies := ObjectDependencies{}
+	if o.dependentRequired != nil {
+		dependencies.Required = make(map[string][]string, len(o.dependentRequired))
+		for field, required := range o.dependentRequired {
+			dependencies.Required[field] = append([]string{}, required...)
+		}
+	}
+	if o.dependentSchemas != nil {
+		dependencies.Schemas = make(map[string]AnyValidator, len(o.dependentSchemas))
+		for field, validator := range o.dependentSchemas {
+			dependencies.Schemas[field] = validator
+		}
+	}
+	return dependencies
+}
+
+// checkConditions applies the dependencies and conditional rules to the
+// input object. Rules validate the whole object, so errors that a field
+// validator already reported are not repeated
+func (o *ObjectValidator[T]) checkConditions(objValue map[string]any, errors []ValidationError, opts Options) []ValidationError {
+	reported := make(map[[2]string]bool, len(errors))
+	for _, err := range errors {
+		reported[[2]string{err.Field, err.Code}] = true
+	}
+	add := func(err ValidationError) {
+		key := [2]string{err.Field, err.Code}
+		if !reported[key] {
+			reported[key] = true
+			errors = append(errors, err)
+		}
+	}
+
+	for _, field := range sortedKeys(o.dependentRequired) {
+		if _, present := objValue[field]; !present {
+			continue
+		}
+		for _, required := range o.dependentRequired[field] {
+			if _, present := objValue[required]; present {
+				continue
+			}
+			err := o.newError(opts, CodeObjectDependentRequired,
+				map[string]any{"field": required, "dependency": field})
+			add(err.prefixed(Key(required)))
+			if o.shouldAbort(errors) {
+				return errors
+			}
+		}
+	}
+
+	for _, field := range sortedKeys(o.dependentSchemas) {
+		if _, present := objValue[field]; !present {
+			continue
+		}
+		for _, err := range validateWithOptions(o.dependentSchemas[field], objValue, opts).Errors {
+			add(err)
+		}
+		if o.shouldAbort(errors) || isCanceled(ValidationResult{Errors: errors}) {
+			return errors
+		}
+	}
+
+	for _, condition := range o.conditions {
+		branch := condition.Else
+		if validateWithOptions(condition.If, objValue, opts).IsValid {
+			branch = condition.Then
+		}
+		if branch == nil {
+			continue
+		}
+		for _, err := range validateWithOptions(branch, objValue, opts).Errors {
+			add(err)
+		}
+		if o.shouldAbort(errors) || isCanceled(ValidationResult{Errors: errors}) {
+			return errors
+		}
+	}
+	return errors
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
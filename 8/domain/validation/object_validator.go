@@ -1,17 +1,133 @@
 package validation
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
+)
+
+// UnknownKeys is the policy for object fields that the schema does not
+// declare
+type UnknownKeys int
+
+const (
+	// UnknownKeysStrict reports unknown fields as unexpected (the default)
+	UnknownKeysStrict UnknownKeys = iota
+	// UnknownKeysStrip accepts unknown fields and leaves them out of the
+	// output value
+	UnknownKeysStrip
+	// UnknownKeysPassthrough accepts unknown fields and keeps them in the
+	// output value
+	UnknownKeysPassthrough
 )
 
 // ObjectValidator validates object values with a schema
 type ObjectValidator[T any] struct {
 	BaseValidator
-	Schema map[string]AnyValidator
+	Schema      map[string]AnyValidator
+	order       []string
+	unknownKeys UnknownKeys
+	// strict is set by Strict, so that an object without a schema rejects
+	// every field instead of accepting any
+	strict       bool
+	catchall     AnyValidator
+	superRefines []func(ctx context.Context, value map[string]any) []ValidationError
+
+	conditions        []Condition
+	dependentRequired map[string][]string
+	dependentSchemas  map[string]AnyValidator
+
+	minProperties *int
+	maxProperties *int
+	propertyNames AnyValidator
+}
+
+// ObjectConstraints describes the constraints on the fields of an object as
+// a whole, configured with MinProperties, MaxProperties and PropertyNames
+type ObjectConstraints struct {
+	MinProperties *int
+	MaxProperties *int
+	PropertyNames AnyValidator
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (o *ObjectValidator[T]) Constraints() ObjectConstraints {
+	return ObjectConstraints{
+		MinProperties: copyPointer(o.minProperties),
+		MaxProperties: copyPointer(o.maxProperties),
+		PropertyNames: o.propertyNames,
+	}
+}
+
+// Fields returns the validators of the declared fields
+func (o *ObjectValidator[T]) Fields() map[string]AnyValidator {
+	return o.Schema
+}
+
+// FieldNames returns the declared fields in the order set by Order, followed
+// by the remaining fields in sorted order. Fields are validated, and their
+// errors reported, in this order
+func (o *ObjectValidator[T]) FieldNames() []string {
+	names := make([]string, 0, len(o.Schema))
+	listed := make(map[string]bool, len(o.order))
+	for _, name := range o.order {
+		if _, declared := o.Schema[name]; declared && !listed[name] {
+			listed[name] = true
+			names = append(names, name)
+		}
+	}
+	rest := make([]string, 0, len(o.Schema)-len(names))
+	for name := range o.Schema {
+		if !listed[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(names, rest...)
+}
+
+// AllowsUnknown reports whether fields missing from the schema are accepted
+func (o *ObjectValidator[T]) AllowsUnknown() bool {
+	return !o.rejectsUnknown() || o.catchall != nil
+}
+
+// rejectsUnknown reports whether the unknown-key policy is strict. It is by
+// default, except for an object without a schema, which accepts any fields
+// unless Strict was called
+func (o *ObjectValidator[T]) rejectsUnknown() bool {
+	return o.unknownKeys == UnknownKeysStrict && (len(o.Schema) > 0 || o.strict)
+}
+
+// UnknownKeyPolicy returns the policy set by Strict, Strip or Passthrough
+func (o *ObjectValidator[T]) UnknownKeyPolicy() UnknownKeys {
+	return o.unknownKeys
+}
+
+// CatchallValidator returns the validator set by Catchall, or nil
+func (o *ObjectValidator[T]) CatchallValidator() AnyValidator {
+	return o.catchall
 }
 
 func (o *ObjectValidator[T]) Validate(value any) ValidationResult {
+	return o.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (o *ObjectValidator[T]) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return o.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (o *ObjectValidator[T]) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Structs may be passed by pointer; a missing value takes the default
+	value = o.applyDefault(indirect(value))
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if o.isOptional() {
@@ -20,50 +136,45 @@ func (o *ObjectValidator[T]) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: o.getMessage("Object value is required"),
-				},
+				o.newError(opts, CodeObjectRequired, nil),
 			},
 		}
 	}
 
-	// Check if the value is actually a map
-	if reflect.TypeOf(value).Kind() != reflect.Map {
+	// Check if the value is actually a map or a struct
+	if kind := reflect.TypeOf(value).Kind(); kind != reflect.Map && kind != reflect.Struct {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: o.getMessage(fmt.Sprintf("Expected object value, got %T", value)),
-				},
+				o.newError(opts, CodeObjectType,
+					map[string]any{"expected": "object", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
 
-	// Convert to map[string]any for validation
-	objValue, ok := value.(map[string]any)
-	if !ok {
-		// Try to convert from other map types
-		val := reflect.ValueOf(value)
-		objValue = make(map[string]any)
-		for _, key := range val.MapKeys() {
-			objValue[fmt.Sprintf("%v", key.Interface())] = val.MapIndex(key).Interface()
-		}
-	}
+	// Convert other map types and structs to map[string]any for validation
+	objValue := toObjectMap(value)
 
-	// If no schema is defined, accept any object
-	if len(o.Schema) == 0 {
-		return ValidationResult{IsValid: true, Errors: nil}
-	}
-
-	// Validate each field in the schema
-	var errors []ValidationError
-	for fieldName, fieldValidator := range o.Schema {
+	// Collect a validation job for each field in the schema, in field order
+	fieldNames := make([]string, 0, len(o.Schema))
+	jobs := make([]validationJob, 0, len(o.Schema))
+	for _, fieldName := range o.FieldNames() {
+		fieldName, fieldValidator := fieldName, o.Schema[fieldName]
 		fieldValue, exists := objValue[fieldName]
 
-		// If field doesn't exist, check if it's optional
+		// If field doesn't exist, check if it has a default or is optional
 		if !exists {
+			// Fields with a default are validated as nil to produce it
+			if defaulted, ok := fieldValidator.(interface{ DefaultValue() (any, bool) }); ok {
+				if _, hasDefault := defaulted.DefaultValue(); hasDefault {
+					fieldNames = append(fieldNames, fieldName)
+					jobs = append(jobs, func(opts Options) ValidationResult {
+						return validateWithOptions(fieldValidator, nil, opts)
+					})
+					continue
+				}
+			}
+
 			// Check if the field validator is optional
 			if optionalValidator, ok := fieldValidator.(interface{ isOptional() bool }); ok {
 				if optionalValidator.isOptional() {
@@ -72,52 +183,360 @@ func (o *ObjectValidator[T]) Validate(value any) ValidationResult {
 			}
 
 			// Field is required but missing
-			errors = append(errors, ValidationError{
-				Field:   fieldName,
-				Message: o.getMessage(fmt.Sprintf("Field '%s' is required", fieldName)),
+			fieldNames = append(fieldNames, fieldName)
+			jobs = append(jobs, func(opts Options) ValidationResult {
+				return ValidationResult{
+					IsValid: false,
+					Errors: []ValidationError{
+						o.newError(opts, CodeObjectMissingField, map[string]any{"field": fieldName}),
+					},
+				}
 			})
 			continue
 		}
 
 		// Validate the field value
-		fieldResult := fieldValidator.Validate(fieldValue)
+		fieldNames = append(fieldNames, fieldName)
+		jobs = append(jobs, func(opts Options) ValidationResult {
+			return validateWithOptions(fieldValidator, fieldValue, opts)
+		})
+	}
+
+	// Validate fields missing from the schema with the catchall validator
+	var unknownFields []string
+	for fieldName := range objValue {
+		if _, exists := o.Schema[fieldName]; !exists {
+			unknownFields = append(unknownFields, fieldName)
+		}
+	}
+	sort.Strings(unknownFields)
+	if o.catchall != nil {
+		for _, fieldName := range unknownFields {
+			fieldValue := objValue[fieldName]
+			fieldNames = append(fieldNames, fieldName)
+			jobs = append(jobs, func(opts Options) ValidationResult {
+				return validateWithOptions(o.catchall, fieldValue, opts)
+			})
+		}
+	}
+
+	// Run the jobs, concurrently on the context path, collecting the field
+	// outputs on top of a copy of the input
+	output := make(map[string]any, len(objValue))
+	for fieldName, fieldValue := range objValue {
+		output[fieldName] = fieldValue
+	}
+	var errors []ValidationError
+	for i, fieldResult := range o.validateAll(jobs, opts) {
+		if fieldResult.IsValid {
+			output[fieldNames[i]] = outputOf(fieldResult, objValue[fieldNames[i]])
+		}
 		if !fieldResult.IsValid {
 			// Add field prefix to all errors from this field
 			for _, fieldError := range fieldResult.Errors {
-				errors = append(errors, ValidationError{
-					Field:   fieldName,
-					Message: fieldError.Message,
-				})
+				errors = append(errors, fieldError.prefixed(Key(fieldNames[i])))
+			}
+			if o.shouldAbort(errors) || isCanceled(fieldResult) {
+				return ValidationResult{IsValid: false, Errors: errors}
 			}
 		}
 	}
 
-	// Check for extra fields (not in schema)
-	for fieldName := range objValue {
-		if _, exists := o.Schema[fieldName]; !exists {
-			errors = append(errors, ValidationError{
-				Field:   fieldName,
-				Message: o.getMessage(fmt.Sprintf("Unexpected field '%s'", fieldName)),
-			})
+	// Apply the unknown-key policy to fields the schema does not declare,
+	// unless the catchall validated them
+	switch {
+	case o.catchall != nil:
+	case o.unknownKeys == UnknownKeysStrip:
+		for _, fieldName := range unknownFields {
+			delete(output, fieldName)
+		}
+	case o.rejectsUnknown():
+		for _, fieldName := range unknownFields {
+			fieldError := o.newError(opts, CodeObjectUnexpectedField,
+				map[string]any{"field": fieldName})
+			errors = append(errors, fieldError.prefixed(Key(fieldName)))
+			if o.shouldAbort(errors) {
+				break
+			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return ValidationResult{
+	// Check the number of fields and their names, unknown ones included
+	if !o.shouldAbort(errors) {
+		errors = o.checkProperties(objValue, errors, opts)
+	}
+
+	// Apply the conditional rules to the input object
+	if !o.shouldAbort(errors) {
+		errors = o.checkConditions(objValue, errors, opts)
+	}
+
+	// Run custom and cross-field checks once every field is valid
+	if len(errors) == 0 {
+		errors = o.refine(output, errors, opts)
+		ctx := opts.ctx()
+		for _, check := range o.superRefines {
+			if o.shouldAbort(errors) || isCanceled(ValidationResult{Errors: errors}) {
+				break
+			}
+			if ctx.Err() != nil {
+				errors = append(errors, canceledError(ctx, opts))
+				break
+			}
+			for _, err := range check(ctx, output) {
+				errors = append(errors, o.refinementError(err, opts))
+			}
+		}
+	}
+
+	// Run transforms once the object is valid
+	return o.finish(output, errors, opts)
+}
+
+// Refine adds a custom check that receives the object as a map[string]any
+// once every field is valid and returns an error, or nil when the value is
+// acceptable
+func (o *ObjectValidator[T]) Refine(check func(value any) *ValidationError) *ObjectValidator[T] {
+	o = clone(o)
+	o.addRefinement(check)
+	return o
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (o *ObjectValidator[T]) RefineContext(check func(ctx context.Context, value any) *ValidationError) *ObjectValidator[T] {
+	o = clone(o)
+	o.addContextRefinement(check)
+	return o
+}
+
+// SuperRefine adds a cross-field check that receives the whole object once
+// every field is valid. It may report any number of errors, each located by
+// its Path (or Field) relative to the object, e.g. to flag confirmPassword
+// when it differs from password
+func (o *ObjectValidator[T]) SuperRefine(check func(value map[string]any) []ValidationError) *ObjectValidator[T] {
+	return o.SuperRefineContext(func(_ context.Context, value map[string]any) []ValidationError {
+		return check(value)
+	})
+}
+
+// SuperRefineContext adds a cross-field check like SuperRefine that also
+// receives the context passed to ValidateContext, e.g. to look values up in
+// an external service
+func (o *ObjectValidator[T]) SuperRefineContext(check func(ctx context.Context, value map[string]any) []ValidationError) *ObjectValidator[T] {
+	o = clone(o)
+	o.superRefines = appendCopy(o.superRefines, check)
+	return o
+}
+
+// Transform adds a function that turns the valid object into the output
+func (o *ObjectValidator[T]) Transform(transform func(value any) (any, error)) *ObjectValidator[T] {
+	o = clone(o)
+	o.addTransform(transform)
+	return o
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (o *ObjectValidator[T]) Default(value T) *ObjectValidator[T] {
+	o = clone(o)
+	o.setDefault(value)
+	return o
+}
+
+// Strict reports fields that are not declared in the schema as unexpected,
+// which is the default. Called explicitly, it also makes an object without
+// a schema reject every field
+func (o *ObjectValidator[T]) Strict() *ObjectValidator[T] {
+	o = clone(o)
+	o.unknownKeys = UnknownKeysStrict
+	o.strict = true
+	return o
+}
+
+// Strip accepts fields that are not declared in the schema and leaves them
+// out of the output value, e.g. to drop client-only fields before storage
+func (o *ObjectValidator[T]) Strip() *ObjectValidator[T] {
+	o = clone(o)
+	o.unknownKeys = UnknownKeysStrip
+	o.strict = false
+	return o
+}
+
+// Passthrough accepts fields that are not declared in the schema instead of
+// reporting them as unexpected
+func (o *ObjectValidator[T]) Passthrough() *ObjectValidator[T] {
+	o = clone(o)
+	o.unknownKeys = UnknownKeysPassthrough
+	o.strict = false
+	return o
+}
+
+// Order sets the order in which fields are validated and their errors
+// reported, usually the order of declaration, since Schema is a map. Fields
+// it does not list follow in sorted order
+func (o *ObjectValidator[T]) Order(names ...string) *ObjectValidator[T] {
+	o = clone(o)
+	o.order = append([]string(nil), names...)
+	return o
+}
+
+// Catchall validates every field that is not declared in the schema with
+// validator, e.g. Catchall(String()) for free-form string labels. It takes
+// precedence over the unknown-key policy
+func (o *ObjectValidator[T]) Catchall(validator AnyValidator) *ObjectValidator[T] {
+	o = clone(o)
+	o.catchall = validator
+	return o
+}
+
+// MinProperties requires at least count fields, declared or not
+func (o *ObjectValidator[T]) MinProperties(count int) *ObjectValidator[T] {
+	o = clone(o)
+	o.minProperties = &count
+	return o
+}
+
+// MaxProperties allows at most count fields, declared or not
+func (o *ObjectValidator[T]) MaxProperties(count int) *ObjectValidator[T] {
+	o = clone(o)
+	o.maxProperties = &count
+	return o
+}
+
+// PropertyNames validates the name of every field, declared or not, e.g.
+// PropertyNames(String().Pattern("^[a-z_]+$"))
+func (o *ObjectValidator[T]) PropertyNames(validator AnyValidator) *ObjectValidator[T] {
+	o = clone(o)
+	o.propertyNames = validator
+	return o
+}
+
+// checkProperties applies MinProperties, MaxProperties and PropertyNames to
+// the input object. Name errors are wrapped like record key errors, so that
+// they are not mistaken for errors in the field's value
+func (o *ObjectValidator[T]) checkProperties(objValue map[string]any, errors []ValidationError, opts Options) []ValidationError {
+	count := len(objValue)
+	if o.minProperties != nil && count < *o.minProperties {
+		errors = append(errors, o.newError(opts, CodeObjectMinProperties,
+			map[string]any{"min": *o.minProperties, "actual": count}))
+	}
+	if o.maxProperties != nil && count > *o.maxProperties {
+		errors = append(errors, o.newError(opts, CodeObjectMaxProperties,
+			map[string]any{"max": *o.maxProperties, "actual": count}))
+	}
+	if o.propertyNames == nil || o.shouldAbort(errors) {
+		return errors
+	}
+
+	for _, name := range sortedKeys(objValue) {
+		for _, keyError := range validateWithOptions(o.propertyNames, name, opts).Errors {
+			if keyError.Code == CodeCanceled {
+				return append(errors, keyError.prefixed(Key(name)))
+			}
+			err := o.newError(opts, CodeObjectInvalidKey,
+				map[string]any{"key": name, "code": keyError.Code, "reason": keyError.Message})
+			errors = append(errors, err.prefixed(Key(name)))
+			if o.shouldAbort(errors) {
+				return errors
+			}
+		}
+	}
+	return errors
+}
+
+// Parse validates the value and, when it is valid, decodes the output value
+// (after coercion, defaults and transforms) into a T. Struct fields are
+// matched by their json tag or, without one, by name. The zero T is returned
+// together with the errors when validation fails
+func (o *ObjectValidator[T]) Parse(value any) (T, ValidationResult) {
+	return o.ParseWithOptions(value, Options{})
+}
+
+// ParseWithOptions is Parse with per-call options such as the message locale
+func (o *ObjectValidator[T]) ParseWithOptions(value any, opts Options) (T, ValidationResult) {
+	var parsed T
+	result := o.ValidateWithOptions(value, opts)
+	if !result.IsValid {
+		return parsed, result
+	}
+
+	if decodeErr := decodeInto(result.Value, &parsed); decodeErr != nil {
+		var zero T
+		validationError := o.newError(opts, CodeObjectDecode,
+			map[string]any{"type": fmt.Sprintf("%T", parsed), "reason": decodeErr.Message})
+		validationError.Path = decodeErr.Path
+		validationError.Field = decodeErr.Path.String()
+		return zero, ValidationResult{
+			IsValid: false,
+			Errors:  []ValidationError{validationError},
+		}
+	}
+	return parsed, result
+}
+
+// ParseJSON decodes raw JSON, validates it and decodes it into a T. Numbers
+// are read as json.Number, so integers beyond float64 precision keep their
+// exact value; fields of type any receive the json.Number itself
+func (o *ObjectValidator[T]) ParseJSON(data []byte) (T, ValidationResult) {
+	return o.ParseJSONWithOptions(data, Options{})
+}
+
+// ParseJSONWithOptions is ParseJSON with per-call options such as the message locale
+func (o *ObjectValidator[T]) ParseJSONWithOptions(data []byte, opts Options) (T, ValidationResult) {
+	value, err := decodeJSON(data)
+	if err != nil {
+		var zero T
+		return zero, ValidationResult{
 			IsValid: false,
-			Errors:  errors,
+			Errors: []ValidationError{
+				o.newError(opts, CodeObjectInvalidJSON, map[string]any{"reason": err.Error()}),
+			},
 		}
 	}
+	return o.ParseWithOptions(value, opts)
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number so
+// that large integers such as IDs are validated and decoded exactly
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return value, nil
+}
 
-	return ValidationResult{IsValid: true, Errors: nil}
+// AbortEarly stops validation at the first invalid field
+func (o *ObjectValidator[T]) AbortEarly() *ObjectValidator[T] {
+	o = clone(o)
+	o.setAbortEarly(true)
+	return o
+}
+
+// CollectAll reports errors for every invalid field (the default)
+func (o *ObjectValidator[T]) CollectAll() *ObjectValidator[T] {
+	o = clone(o)
+	o.setAbortEarly(false)
+	return o
 }
 
 func (o *ObjectValidator[T]) Optional() Validator[T] {
+	o = clone(o)
 	o.setOptional()
 	return o
 }
 
+// Nullable also accepts null, see NullableValidator
+func (o *ObjectValidator[T]) Nullable() *NullableValidator {
+	return nullableOf(o, o.optional)
+}
+
 func (o *ObjectValidator[T]) WithMessage(message string) Validator[T] {
+	o = clone(o)
 	o.setMessage(message)
 	return o
 }
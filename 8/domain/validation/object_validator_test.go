@@ -1,6 +1,11 @@
 package validation
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -93,7 +98,7 @@ func TestObjectValidator_ValidateMissingRequiredField(t *testing.T) {
 func TestObjectValidator_ValidateOptionalField(t *testing.T) {
 	stringValidator := &StringValidator{}
 	numberValidator := &NumberValidator{}
-	numberValidator.Optional() // Make age optional
+	numberValidator = numberValidator.Optional().(*NumberValidator) // Make age optional
 
 	schema := map[string]AnyValidator{
 		"name": stringValidator,
@@ -214,8 +219,7 @@ func TestObjectValidator_ValidateNilOptional(t *testing.T) {
 	validator := NewObjectValidator[map[string]any]()
 
 	// Test with nil object (optional)
-	validator.Optional()
-	result := validator.Validate(nil)
+	result := validator.Optional().Validate(nil)
 	if !result.IsValid {
 		t.Error("Object validator should accept nil object when optional")
 	}
@@ -236,7 +240,7 @@ func TestObjectValidator_ValidateInvalidType(t *testing.T) {
 		true,
 		false,
 		[]string{"item1", "item2"},
-		&ObjectValidator[map[string]any]{},
+		&[]string{"item"}, // structs are valid objects, see TestObjectValidator_ValidateStruct
 	}
 
 	for _, testCase := range invalidTestCases {
@@ -294,10 +298,10 @@ func TestObjectValidator_ValidateEmptyObjectWithSchema(t *testing.T) {
 func TestObjectValidator_ValidateComplexSchema(t *testing.T) {
 	// Create a complex schema with nested validation
 	stringValidator := &StringValidator{}
-	stringValidator.MinLength(2).MaxLength(50)
+	stringValidator = stringValidator.MinLength(2).MaxLength(50)
 
 	numberValidator := &NumberValidator{}
-	numberValidator.Min(0).Max(150)
+	numberValidator = numberValidator.Min(0).Max(150)
 
 	booleanValidator := &BooleanValidator{}
 
@@ -351,13 +355,17 @@ func TestObjectValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
 	}
+	copied := result.(*ObjectValidator[map[string]any])
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -368,13 +376,17 @@ func TestObjectValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
+	}
+	copied := result.(*ObjectValidator[map[string]any])
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -387,16 +399,20 @@ func TestObjectValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
 	}
+	copied := result.(*ObjectValidator[map[string]any])
 
 	// Should have all properties set
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -459,9 +475,8 @@ func TestObjectValidator_ErrorMessages(t *testing.T) {
 }
 
 func TestObjectValidator_CustomMessage(t *testing.T) {
-	validator := NewObjectValidator[map[string]any]()
 	customMessage := "Custom object validation message"
-	validator.WithMessage(customMessage)
+	validator := NewObjectValidator[map[string]any]().WithMessage(customMessage)
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate("not an object")
@@ -475,3 +490,755 @@ func TestObjectValidator_CustomMessage(t *testing.T) {
 		t.Errorf("Expected custom message '%s', got '%s'", customMessage, result.Errors[0].Message)
 	}
 }
+
+func TestObjectValidator_AbortEarly(t *testing.T) {
+	validator := NewObjectValidator[map[string]any]()
+	validator.Schema = map[string]AnyValidator{
+		"name": &StringValidator{},
+		"age":  &NumberValidator{},
+	}
+	validator = validator.AbortEarly()
+
+	result := validator.Validate(map[string]any{"extra": true})
+	if result.IsValid {
+		t.Error("Object validator should reject object with several problems")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error in abort-early mode, got %d", len(result.Errors))
+	}
+
+	validator = validator.CollectAll()
+	result = validator.Validate(map[string]any{"extra": true})
+	if len(result.Errors) != 3 {
+		t.Errorf("Expected 3 errors in collect-all mode, got %d", len(result.Errors))
+	}
+}
+
+func TestObjectValidator_Passthrough(t *testing.T) {
+	validator := NewObjectValidator[map[string]any]()
+	validator.Schema = map[string]AnyValidator{
+		"name": &StringValidator{},
+	}
+
+	passthrough := validator.Passthrough()
+	result := passthrough.Validate(map[string]any{"name": "John", "city": "Paris"})
+	if !result.IsValid {
+		t.Errorf("Object validator should accept unknown fields in passthrough mode, got %+v", result.Errors)
+	}
+
+	// Declared fields are still validated
+	result = passthrough.Validate(map[string]any{"name": 42, "city": "Paris"})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Errorf("Expected exactly 1 error for invalid declared field, got %+v", result.Errors)
+	}
+}
+
+type parsedUser struct {
+	Name string   `json:"name"`
+	Age  int      `json:"age"`
+	Tags []string `json:"tags"`
+}
+
+func TestObjectValidator_Parse(t *testing.T) {
+	validator := &ObjectValidator[parsedUser]{
+		Schema: map[string]AnyValidator{
+			"name": (&StringValidator{}).MinLength(2),
+			"age":  (&NumberValidator{}).Min(0),
+			"tags": &ArrayValidator[any]{ItemValidator: &StringValidator{}},
+		},
+	}
+
+	user, result := validator.Parse(map[string]any{
+		"name": "John",
+		"age":  30,
+		"tags": []any{"go"},
+	})
+	if !result.IsValid {
+		t.Fatalf("Expected valid value, got errors: %+v", result.Errors)
+	}
+	expected := parsedUser{Name: "John", Age: 30, Tags: []string{"go"}}
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, user)
+	}
+
+	user, result = validator.Parse(map[string]any{"name": "J", "age": 30, "tags": []any{}})
+	if result.IsValid {
+		t.Error("Parse should reject invalid values")
+	}
+	if !reflect.DeepEqual(user, parsedUser{}) {
+		t.Errorf("Parse should return the zero value on failure, got %+v", user)
+	}
+}
+
+func TestObjectValidator_ParseDecodeError(t *testing.T) {
+	// The schema accepts fractional ages but the struct field is an int
+	validator := &ObjectValidator[parsedUser]{
+		Schema: map[string]AnyValidator{
+			"name": &StringValidator{},
+			"age":  &NumberValidator{},
+			"tags": &ArrayValidator[any]{},
+		},
+	}
+
+	_, result := validator.Parse(map[string]any{"name": "John", "age": 30.5, "tags": []any{}})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 decode error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeObjectDecode {
+		t.Errorf("Expected code '%s', got '%s'", CodeObjectDecode, result.Errors[0].Code)
+	}
+	if result.Errors[0].Field != "age" {
+		t.Errorf("Expected decode error at 'age', got '%s'", result.Errors[0].Field)
+	}
+}
+
+func TestObjectValidator_ParseJSON(t *testing.T) {
+	validator := &ObjectValidator[parsedUser]{
+		Schema: map[string]AnyValidator{
+			"name": &StringValidator{},
+			"age":  (&NumberValidator{}).Max(150),
+			"tags": &ArrayValidator[any]{ItemValidator: &StringValidator{}},
+		},
+	}
+
+	user, result := validator.ParseJSON([]byte(`{"name": "Ana", "age": 41, "tags": ["a", "b"]}`))
+	if !result.IsValid {
+		t.Fatalf("Expected valid JSON, got errors: %+v", result.Errors)
+	}
+	if user.Name != "Ana" || user.Age != 41 || len(user.Tags) != 2 {
+		t.Errorf("Unexpected parsed value %+v", user)
+	}
+
+	_, result = validator.ParseJSON([]byte(`{"name": "Ana", "age": 200, "tags": []}`))
+	if result.IsValid || result.Errors[0].Code != CodeNumberMax {
+		t.Errorf("Expected max error, got %+v", result.Errors)
+	}
+
+	_, result = validator.ParseJSON([]byte(`{"name": `))
+	if result.IsValid || result.Errors[0].Code != CodeObjectInvalidJSON {
+		t.Errorf("Expected invalid JSON error, got %+v", result.Errors)
+	}
+
+	_, result = validator.ParseJSON([]byte(`{"name": "Ana", "age": 41, "tags": []} {}`))
+	if result.IsValid || result.Errors[0].Code != CodeObjectInvalidJSON {
+		t.Errorf("Expected invalid JSON error for trailing data, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_ParseJSONLargeIntegers(t *testing.T) {
+	type record struct {
+		ID int64 `json:"id"`
+	}
+	validator := &ObjectValidator[record]{Schema: map[string]AnyValidator{
+		"id": (&NumberValidator{}).Int(),
+	}}
+
+	// 2^53 + 1 has no exact float64 representation
+	parsed, result := validator.ParseJSON([]byte(`{"id": 9007199254740993}`))
+	if !result.IsValid {
+		t.Fatalf("Expected valid JSON, got errors: %+v", result.Errors)
+	}
+	if parsed.ID != 9007199254740993 {
+		t.Errorf("Expected ID 9007199254740993, got %d", parsed.ID)
+	}
+
+	// Bounds compare exactly too: 2^53 + 1 is above a maximum of 2^53
+	bounded := &ObjectValidator[record]{Schema: map[string]AnyValidator{
+		"id": (&NumberValidator{}).Int().Max(9007199254740992),
+	}}
+	_, result = bounded.ParseJSON([]byte(`{"id": 9007199254740993}`))
+	if result.IsValid || result.Errors[0].Code != CodeNumberMax {
+		t.Errorf("Expected max error just above the bound, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_ParseJSONExactNumbers(t *testing.T) {
+	type ledger struct {
+		ID      *big.Int    `json:"id"`
+		Ratio   big.Rat     `json:"ratio"`
+		Balance *big.Float  `json:"balance"`
+		Amount  json.Number `json:"amount"`
+		Counts  []*big.Int  `json:"counts"`
+	}
+	validator := &ObjectValidator[ledger]{Schema: map[string]AnyValidator{
+		"id":      (&NumberValidator{}).Int(),
+		"ratio":   &NumberValidator{},
+		"balance": &NumberValidator{},
+		"amount":  &NumberValidator{},
+		"counts":  &ArrayValidator[any]{ItemValidator: (&NumberValidator{}).Int()},
+	}}
+
+	parsed, result := validator.ParseJSON([]byte(`{
+		"id": 123456789012345678901234567890,
+		"ratio": 0.1,
+		"balance": 2.5,
+		"amount": 1e2,
+		"counts": [18446744073709551616]
+	}`))
+	if !result.IsValid {
+		t.Fatalf("Expected valid JSON, got errors: %+v", result.Errors)
+	}
+	if parsed.ID == nil || parsed.ID.String() != "123456789012345678901234567890" {
+		t.Errorf("Expected the exact id, got %v", parsed.ID)
+	}
+	if parsed.Ratio.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("Expected ratio 1/10, got %v", parsed.Ratio.String())
+	}
+	if parsed.Balance == nil || parsed.Balance.String() != "2.5" {
+		t.Errorf("Expected balance 2.5, got %v", parsed.Balance)
+	}
+	if parsed.Amount != "1e2" {
+		t.Errorf("Expected amount to keep its JSON text, got %q", parsed.Amount)
+	}
+	if len(parsed.Counts) != 1 || parsed.Counts[0].String() != "18446744073709551616" {
+		t.Errorf("Expected counts beyond uint64, got %v", parsed.Counts)
+	}
+
+	// Numbers decode into json.Number, and fractions do not fit a big.Int
+	numbers, result := (&ObjectValidator[ledger]{Schema: map[string]AnyValidator{
+		"amount": &NumberValidator{},
+	}}).Parse(map[string]any{"amount": int64(9007199254740993)})
+	if !result.IsValid || numbers.Amount != "9007199254740993" {
+		t.Errorf("Expected amount 9007199254740993, got %q, %+v", numbers.Amount, result.Errors)
+	}
+	_, result = (&ObjectValidator[ledger]{Schema: map[string]AnyValidator{
+		"id": &NumberValidator{},
+	}}).ParseJSON([]byte(`{"id": 1.5}`))
+	if result.IsValid || result.Errors[0].Code != CodeObjectDecode || result.Errors[0].Field != "id" {
+		t.Errorf("Expected a decode error at 'id', got %+v", result.Errors)
+	}
+}
+
+type structAddress struct {
+	City string `json:"city"`
+}
+
+type structUser struct {
+	Name    string         `json:"name"`
+	Age     *int           `json:"age,omitempty"`
+	Address *structAddress `json:"address"`
+	Tags    []*string      `json:"tags"`
+	Secret  string         `json:"-"`
+	hidden  string
+}
+
+func TestObjectValidator_ValidateStruct(t *testing.T) {
+	validator := &ObjectValidator[structUser]{
+		Schema: map[string]AnyValidator{
+			"name":    (&StringValidator{}).MinLength(2),
+			"age":     (&NumberValidator{}).Min(0).Optional(),
+			"address": &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{"city": &StringValidator{}}},
+			"tags":    &ArrayValidator[any]{ItemValidator: (&StringValidator{}).MinLength(2)},
+		},
+	}
+
+	tag := "go"
+	user := structUser{Name: "John", Address: &structAddress{City: "Recife"}, Tags: []*string{&tag}, hidden: "x"}
+	if result := validator.Validate(user); !result.IsValid {
+		t.Errorf("Expected valid struct, got errors: %+v", result.Errors)
+	}
+	if result := validator.Validate(&user); !result.IsValid {
+		t.Errorf("Expected valid struct pointer, got errors: %+v", result.Errors)
+	}
+
+	short := "x"
+	age := -1
+	invalid := structUser{Name: "J", Age: &age, Tags: []*string{&short}}
+	result := validator.Validate(invalid)
+	if result.IsValid {
+		t.Fatal("Expected invalid struct")
+	}
+	fields := make(map[string]bool)
+	for _, err := range result.Errors {
+		fields[err.Field] = true
+	}
+	for _, field := range []string{"name", "age", "address", "tags[0]"} {
+		if !fields[field] {
+			t.Errorf("Expected error for '%s', got %+v", field, result.Errors)
+		}
+	}
+
+	var nilUser *structUser
+	if result := validator.Validate(nilUser); result.IsValid {
+		t.Error("Expected nil struct pointer to be rejected when not optional")
+	}
+}
+
+func TestObjectValidator_Refine(t *testing.T) {
+	validator := NewObjectValidator[map[string]any]().Passthrough().Refine(func(value any) *ValidationError {
+		if len(value.(map[string]any)) > 2 {
+			return &ValidationError{Message: "Too many fields"}
+		}
+		return nil
+	})
+
+	if result := validator.Validate(map[string]any{"a": 1}); !result.IsValid {
+		t.Errorf("Expected small object to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate(map[string]any{"a": 1, "b": 2, "c": 3})
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Field != "" {
+		t.Errorf("Expected 1 error at the object root, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_SuperRefine(t *testing.T) {
+	validator := NewObjectValidator[map[string]any]()
+	validator.Schema = map[string]AnyValidator{
+		"password":        (&StringValidator{}).MinLength(6),
+		"confirmPassword": &StringValidator{},
+		"address": &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+			"country": &StringValidator{},
+			"zip":     (&StringValidator{}).Optional(),
+		}},
+	}
+	validator = validator.SuperRefine(func(value map[string]any) []ValidationError {
+		if value["password"] != value["confirmPassword"] {
+			return []ValidationError{{Field: "confirmPassword", Code: "password_mismatch", Message: "Passwords do not match"}}
+		}
+		return nil
+	}).SuperRefine(func(value map[string]any) []ValidationError {
+		address := value["address"].(map[string]any)
+		if address["country"] == "US" && address["zip"] == nil {
+			return []ValidationError{{Path: Path{Key("address"), Key("zip")}, Message: "ZIP code is required in the US"}}
+		}
+		return nil
+	})
+
+	valid := map[string]any{
+		"password":        "secret",
+		"confirmPassword": "secret",
+		"address":         map[string]any{"country": "US", "zip": "10001"},
+	}
+	if result := validator.Validate(valid); !result.IsValid {
+		t.Errorf("Expected matching passwords to be valid, got %+v", result.Errors)
+	}
+
+	result := validator.Validate(map[string]any{
+		"password":        "secret",
+		"confirmPassword": "secrets",
+		"address":         map[string]any{"country": "US"},
+	})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "confirmPassword" || result.Errors[0].Code != "password_mismatch" {
+		t.Errorf("Expected mismatch error at 'confirmPassword', got %+v", result.Errors[0])
+	}
+	if result.Errors[1].Field != "address.zip" || result.Errors[1].Code != CodeCustom {
+		t.Errorf("Expected custom error at 'address.zip', got %+v", result.Errors[1])
+	}
+
+	// Cross-field checks only run once every field is valid
+	result = validator.Validate(map[string]any{
+		"password":        "short",
+		"confirmPassword": "other",
+		"address":         map[string]any{"country": "US"},
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Code != CodeStringMinLength {
+		t.Errorf("Expected only the field error, got %+v", result.Errors)
+	}
+
+	// Abort-early mode stops after the first failed check
+	validator = validator.AbortEarly()
+	result = validator.Validate(map[string]any{
+		"password":        "secret",
+		"confirmPassword": "secrets",
+		"address":         map[string]any{"country": "US"},
+	})
+	if len(result.Errors) != 1 {
+		t.Errorf("Expected 1 error in abort-early mode, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_Output(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"email":    (&StringValidator{}).Trim().ToLower(),
+		"page":     (&NumberValidator{}).Coerce().Default(1),
+		"nickname": (&StringValidator{}).Optional(),
+	}}
+
+	input := map[string]any{"email": " John@Example.com "}
+	result := validator.Validate(input)
+	expected := map[string]any{"email": "john@example.com", "page": 1.0}
+	if !result.IsValid || !reflect.DeepEqual(result.Value, expected) {
+		t.Errorf("Expected %v, got %+v", expected, result)
+	}
+	// The input is left untouched
+	if input["email"] != " John@Example.com " || len(input) != 1 {
+		t.Errorf("Expected input to be unchanged, got %v", input)
+	}
+
+	result = validator.Validate(map[string]any{"email": "a@b.c", "page": "3"})
+	if result.Value.(map[string]any)["page"] != json.Number("3") {
+		t.Errorf("Expected coerced page, got %+v", result.Value)
+	}
+}
+
+func TestObjectValidator_ParseTransformed(t *testing.T) {
+	type query struct {
+		Search string `json:"q"`
+		Limit  int    `json:"limit"`
+		Active bool   `json:"active"`
+	}
+	validator := &ObjectValidator[query]{Schema: map[string]AnyValidator{
+		"q":      (&StringValidator{}).Trim(),
+		"limit":  (&NumberValidator{}).Coerce().Max(100).Default(20),
+		"active": (&BooleanValidator{}).Coerce(),
+	}}
+
+	parsed, result := validator.Parse(map[string]any{"q": " shoes ", "active": "true"})
+	if !result.IsValid {
+		t.Fatalf("Expected query to be valid, got %+v", result.Errors)
+	}
+	if parsed != (query{Search: "shoes", Limit: 20, Active: true}) {
+		t.Errorf("Expected decoded output, got %+v", parsed)
+	}
+	if parsed, _ := validator.Parse(map[string]any{"q": "", "limit": "50", "active": "0"}); parsed.Limit != 50 {
+		t.Errorf("Expected coerced limit to decode into an int, got %+v", parsed)
+	}
+}
+
+func TestObjectValidator_UnknownKeyPolicies(t *testing.T) {
+	newValidator := func() *ObjectValidator[map[string]any] {
+		return &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{"name": &StringValidator{}}}
+	}
+	input := map[string]any{"name": "John", "city": "Paris"}
+
+	if result := newValidator().Strict().Validate(input); result.IsValid || result.Errors[0].Field != "city" {
+		t.Errorf("Expected unexpected field error in strict mode, got %+v", result.Errors)
+	}
+
+	result := newValidator().Strip().Validate(input)
+	if !result.IsValid || !reflect.DeepEqual(result.Value, map[string]any{"name": "John"}) {
+		t.Errorf("Expected unknown field to be stripped, got %+v", result)
+	}
+
+	result = newValidator().Passthrough().Validate(input)
+	if !result.IsValid || !reflect.DeepEqual(result.Value, input) {
+		t.Errorf("Expected unknown field to pass through, got %+v", result)
+	}
+}
+
+func TestObjectValidator_StrictWithoutSchema(t *testing.T) {
+	empty := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{}}
+	input := map[string]any{"a": 1}
+
+	if result := empty.Validate(input); !result.IsValid || !empty.AllowsUnknown() {
+		t.Errorf("Expected an object without a schema to accept any field, got %+v", result.Errors)
+	}
+	strict := empty.Strict()
+	if result := strict.Validate(input); result.IsValid || result.Errors[0].Code != CodeObjectUnexpectedField {
+		t.Errorf("Expected explicit Strict to reject every field, got %+v", result.Errors)
+	}
+	if strict.AllowsUnknown() {
+		t.Error("Expected explicit Strict to report that unknown fields are rejected")
+	}
+	if result := strict.Validate(map[string]any{}); !result.IsValid {
+		t.Errorf("Expected an empty object to be valid, got %+v", result.Errors)
+	}
+
+	// The policy survives composition, and a later policy replaces it
+	if result := strict.Partial().Validate(input); result.IsValid {
+		t.Error("Expected composition to keep explicit Strict")
+	}
+	if result := empty.Merge(strict).Validate(input); result.IsValid {
+		t.Error("Expected Merge to take the explicit Strict of the other object")
+	}
+	if result := strict.Passthrough().Validate(input); !result.IsValid {
+		t.Errorf("Expected Passthrough to replace Strict, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_Catchall(t *testing.T) {
+	validator := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name": &StringValidator{},
+	}}).Strip().Catchall((&StringValidator{}).Trim())
+
+	result := validator.Validate(map[string]any{"name": "John", "city": " Paris "})
+	if !result.IsValid || !reflect.DeepEqual(result.Value, map[string]any{"name": "John", "city": "Paris"}) {
+		t.Errorf("Expected catchall output to be kept, got %+v", result)
+	}
+
+	result = validator.Validate(map[string]any{"name": "John", "b": 1, "a": true})
+	if len(result.Errors) != 2 || result.Errors[0].Field != "a" || result.Errors[1].Field != "b" {
+		t.Fatalf("Expected catchall errors at 'a' and 'b', got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeStringType {
+		t.Errorf("Expected string type error, got %+v", result.Errors[0])
+	}
+}
+
+func TestObjectValidator_Composition(t *testing.T) {
+	name := (&StringValidator{}).MinLength(2)
+	user := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name":  name,
+		"email": (&StringValidator{}).Email(),
+		"age":   (&NumberValidator{}).Optional(),
+	}}).Strip()
+
+	patch := user.Partial()
+	if result := patch.Validate(map[string]any{"age": 30, "extra": 1}); !result.IsValid {
+		t.Errorf("Expected partial object to accept missing fields, got %+v", result.Errors)
+	}
+	if result := patch.Validate(map[string]any{"name": "J"}); result.IsValid || result.Errors[0].Code != CodeStringMinLength {
+		t.Errorf("Expected partial fields to keep their constraints, got %+v", result.Errors)
+	}
+	if name.IsOptional() || user.Fields()["name"].(*StringValidator).IsOptional() {
+		t.Error("Partial should not change the original fields")
+	}
+
+	strict := user.Required()
+	if result := strict.Validate(map[string]any{"name": "John", "email": "j@example.com"}); result.IsValid || result.Errors[0].Field != "age" {
+		t.Errorf("Expected age to be required, got %+v", result.Errors)
+	}
+	if !user.Fields()["age"].(*NumberValidator).IsOptional() {
+		t.Error("Required should not change the original fields")
+	}
+
+	picked := user.Pick("name")
+	if len(picked.Fields()) != 1 || picked.UnknownKeyPolicy() != UnknownKeysStrip {
+		t.Errorf("Expected one field and the strip policy, got %v", picked.Fields())
+	}
+	if omitted := user.Omit("email", "age"); !reflect.DeepEqual(omitted.Fields(), picked.Fields()) {
+		t.Errorf("Expected Omit to mirror Pick, got %v", omitted.Fields())
+	}
+
+	extended := user.Extend(map[string]AnyValidator{"role": &EnumValidator{Values: []any{"admin", "user"}}})
+	if len(extended.Fields()) != 4 || len(user.Fields()) != 3 {
+		t.Errorf("Expected extend to add a field to a copy, got %v", extended.Fields())
+	}
+
+	audit := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"createdAt": &DateValidator{},
+	}}).Passthrough()
+	merged := user.Merge(audit)
+	if len(merged.Fields()) != 4 || merged.UnknownKeyPolicy() != UnknownKeysPassthrough {
+		t.Errorf("Expected merged fields and policy, got %v, %v", merged.Fields(), merged.UnknownKeyPolicy())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Pick to panic on an undeclared field")
+		}
+	}()
+	user = user.Pick("missing")
+}
+
+func TestObjectValidator_MergeKeepsRules(t *testing.T) {
+	optional := func() AnyValidator { return (&StringValidator{}).Optional() }
+	base := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"card_number": optional(),
+		"cvv":         optional(),
+	}}).DependentRequired(map[string][]string{"card_number": {"cvv"}})
+	shipping := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"delivery":         NewEnumValidator("ship", "pickup"),
+		"shipping_address": optional(),
+		"gift":             optional(),
+		"note":             optional(),
+	}}).When("delivery", NewLiteralValidator("ship")).Then(&ObjectValidator[map[string]any]{
+		Schema:      map[string]AnyValidator{"shipping_address": &StringValidator{}},
+		unknownKeys: UnknownKeysPassthrough,
+	}).DependentRequired(map[string][]string{"card_number": {"holder"}, "gift": {"note"}}).
+		DependentSchemas(map[string]AnyValidator{"gift": &ObjectValidator[map[string]any]{
+			Schema:      map[string]AnyValidator{"note": (&StringValidator{}).MinLength(3)},
+			unknownKeys: UnknownKeysPassthrough,
+		}})
+
+	merged := base.Merge(shipping.Extend(map[string]AnyValidator{"holder": optional()}))
+	if len(merged.Conditions()) != 1 {
+		t.Errorf("Expected the condition of the merged object, got %+v", merged.Conditions())
+	}
+	if required := merged.Dependencies().Required["card_number"]; !reflect.DeepEqual(required, []string{"cvv", "holder"}) {
+		t.Errorf("Expected dependencies of both objects, got %v", required)
+	}
+
+	testCases := map[string]struct {
+		value map[string]any
+		field string
+	}{
+		"condition":          {map[string]any{"delivery": "ship"}, "shipping_address"},
+		"own dependency":     {map[string]any{"delivery": "pickup", "card_number": "4111", "holder": "Jo"}, "cvv"},
+		"merged dependency":  {map[string]any{"delivery": "pickup", "card_number": "4111", "cvv": "123"}, "holder"},
+		"dependent schema":   {map[string]any{"delivery": "pickup", "gift": "yes", "note": "hi"}, "note"},
+		"dependent required": {map[string]any{"delivery": "pickup", "gift": "yes"}, "note"},
+	}
+	for name, tc := range testCases {
+		result := merged.Validate(tc.value)
+		if result.IsValid || result.Errors[0].Field != tc.field {
+			t.Errorf("%s: expected an error at '%s', got %+v", name, tc.field, result.Errors)
+		}
+	}
+	if result := merged.Validate(map[string]any{"delivery": "pickup"}); !result.IsValid {
+		t.Errorf("Expected a value without triggers to be valid, got %+v", result.Errors)
+	}
+	if len(base.Conditions()) != 0 || len(base.Dependencies().Required["card_number"]) != 1 {
+		t.Error("Merge should not change the receiver")
+	}
+}
+
+func TestObjectValidator_When(t *testing.T) {
+	order := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"delivery":         NewEnumValidator("ship", "pickup"),
+		"shipping_address": (&StringValidator{}).Optional(),
+	}}).When("delivery", NewLiteralValidator("ship")).Then(&ObjectValidator[map[string]any]{
+		Schema:      map[string]AnyValidator{"shipping_address": (&StringValidator{}).MinLength(5)},
+		unknownKeys: UnknownKeysPassthrough,
+	})
+
+	if result := order.Validate(map[string]any{"delivery": "pickup"}); !result.IsValid {
+		t.Errorf("Expected pickup without address to be valid, got %+v", result.Errors)
+	}
+	result := order.Validate(map[string]any{"delivery": "ship"})
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Code != CodeObjectMissingField || result.Errors[0].Field != "shipping_address" {
+		t.Errorf("Expected missing shipping_address, got %+v", result.Errors)
+	}
+	result = order.Validate(map[string]any{"delivery": "ship", "shipping_address": "x"})
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Code != CodeStringMinLength {
+		t.Errorf("Expected a single min length error, got %+v", result.Errors)
+	}
+}
+
+func TestObjectValidator_ThenElse(t *testing.T) {
+	passthrough := func(schema map[string]AnyValidator) *ObjectValidator[map[string]any] {
+		return &ObjectValidator[map[string]any]{Schema: schema, unknownKeys: UnknownKeysPassthrough}
+	}
+	account := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"type":       NewEnumValidator("person", "company"),
+		"cpf":        (&StringValidator{}).Optional(),
+		"company_id": (&StringValidator{}).Optional(),
+	}}).If(passthrough(map[string]AnyValidator{"type": NewLiteralValidator("person")})).ThenElse(
+		passthrough(map[string]AnyValidator{"cpf": &StringValidator{}}),
+		passthrough(map[string]AnyValidator{"company_id": &StringValidator{}}),
+	)
+
+	result := account.Validate(map[string]any{"type": "company"})
+	if result.IsValid || result.Errors[0].Field != "company_id" {
+		t.Errorf("Expected the else branch to require company_id, got %+v", result.Errors)
+	}
+	if result := account.Validate(map[string]any{"type": "person", "cpf": "123"}); !result.IsValid {
+		t.Errorf("Expected person with cpf to be valid, got %+v", result.Errors)
+	}
+	if conditions := account.Conditions(); len(conditions) != 1 || conditions[0].Else == nil {
+		t.Errorf("Expected one if/then/else condition, got %+v", conditions)
+	}
+}
+
+func TestObjectValidator_Dependencies(t *testing.T) {
+	payment := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"card_number": (&StringValidator{}).Optional(),
+		"cvv":         (&StringValidator{}).Optional(),
+		"coupon":      (&StringValidator{}).Optional(),
+		"total":       &NumberValidator{},
+	}}).DependentRequired(map[string][]string{"card_number": {"cvv"}}).
+		DependentSchemas(map[string]AnyValidator{
+			"coupon": &ObjectValidator[map[string]any]{
+				Schema:      map[string]AnyValidator{"total": (&NumberValidator{}).Min(10)},
+				unknownKeys: UnknownKeysPassthrough,
+			},
+		})
+
+	result := payment.Validate(map[string]any{"card_number": "4111", "total": 5})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %+v", result.Errors)
+	}
+	if err := result.Errors[0]; err.Code != CodeObjectDependentRequired || err.Field != "cvv" || err.Params["dependency"] != "card_number" {
+		t.Errorf("Expected dependent required error at cvv, got %+v", err)
+	}
+
+	result = payment.Validate(map[string]any{"coupon": "SALE", "total": 5})
+	if result.IsValid || result.Errors[0].Code != CodeNumberMin || result.Errors[0].Field != "total" {
+		t.Errorf("Expected the coupon schema to require a total of 10, got %+v", result.Errors)
+	}
+	if result := payment.Validate(map[string]any{"total": 5}); !result.IsValid {
+		t.Errorf("Expected no dependencies to apply, got %+v", result.Errors)
+	}
+
+	// Composition keeps the rules
+	if partial := payment.Partial(); len(partial.Dependencies().Required["card_number"]) != 1 {
+		t.Errorf("Expected Partial to keep dependentRequired, got %+v", partial.Dependencies())
+	}
+}
+
+func TestObjectValidator_ErrorOrder(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"zip":   &StringValidator{},
+		"city":  &StringValidator{},
+		"email": &StringValidator{},
+		"age":   &NumberValidator{},
+	}}
+	value := map[string]any{"zip": 1, "city": 2, "email": 3, "age": "x", "b": 1, "a": 2}
+
+	// Without an order, declared fields come in sorted order, then unknown
+	// fields sorted, on every run
+	expected := "age,city,email,zip,a,b"
+	for i := 0; i < 20; i++ {
+		if fields := errorFields(validator.Validate(value)); fields != expected {
+			t.Fatalf("Expected errors at %s, got %s", expected, fields)
+		}
+	}
+
+	// Order lists fields first; the rest follow in sorted order
+	ordered := validator.Order("zip", "email")
+	if fields := errorFields(ordered.Validate(value)); fields != "zip,email,age,city,a,b" {
+		t.Errorf("Expected errors in the given order, got %s", fields)
+	}
+	if fields := errorFields(ordered.ValidateContext(context.Background(), value)); fields != "zip,email,age,city,a,b" {
+		t.Errorf("Expected the same order on the context path, got %s", fields)
+	}
+
+	// Composition keeps the order and adds new fields after it
+	extended := ordered.Omit("age").Extend(map[string]AnyValidator{"country": &StringValidator{}, "address": &StringValidator{}})
+	if names := strings.Join(extended.FieldNames(), ","); names != "zip,email,city,address,country" {
+		t.Errorf("Expected extended field order, got %s", names)
+	}
+	if names := strings.Join(ordered.Pick("city", "zip").FieldNames(), ","); names != "city,zip" {
+		t.Errorf("Expected Pick to use the given order, got %s", names)
+	}
+}
+
+// errorFields joins the fields of the errors of a result
+func errorFields(result ValidationResult) string {
+	fields := make([]string, len(result.Errors))
+	for i, err := range result.Errors {
+		fields[i] = err.Field
+	}
+	return strings.Join(fields, ",")
+}
+
+func TestObjectValidator_PropertyConstraints(t *testing.T) {
+	validator := (&ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name": &StringValidator{},
+	}}).Passthrough().MinProperties(2).MaxProperties(3).PropertyNames((&StringValidator{}).Pattern(`^[a-z]+$`))
+
+	if result := validator.Validate(map[string]any{"name": "Ana", "role": "admin"}); !result.IsValid {
+		t.Errorf("Expected valid object, got %+v", result.Errors)
+	}
+
+	result := validator.Validate(map[string]any{"name": "Ana"})
+	if result.IsValid || result.Errors[0].Code != CodeObjectMinProperties || result.Errors[0].Field != "" {
+		t.Errorf("Expected min properties error, got %+v", result.Errors)
+	}
+	result = validator.Validate(map[string]any{"name": "Ana", "a": 1, "b": 2, "c": 3})
+	if result.IsValid || result.Errors[0].Code != CodeObjectMaxProperties {
+		t.Errorf("Expected max properties error, got %+v", result.Errors)
+	}
+
+	// Name errors are reported under the field, with the reason from the
+	// name validator
+	result = validator.Validate(map[string]any{"name": "Ana", "Role": "admin"})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Code != CodeObjectInvalidKey || err.Field != "Role" || err.Params["code"] != CodeStringPattern {
+		t.Errorf("Expected invalid key error at 'Role', got %+v", err)
+	}
+
+	// Composition keeps the constraints
+	if result := validator.Partial().Validate(map[string]any{"name": "Ana"}); result.IsValid {
+		t.Error("Expected Partial to keep MinProperties")
+	}
+	if constraints := validator.Constraints(); *constraints.MinProperties != 2 || *constraints.MaxProperties != 3 || constraints.PropertyNames == nil {
+		t.Errorf("Unexpected constraints %+v", constraints)
+	}
+}
@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PathSegment is a single step into a nested value: either an object key
+// or an array index
+type PathSegment struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+// Key creates a path segment for an object key
+func Key(name string) PathSegment {
+	return PathSegment{Key: name}
+}
+
+// Index creates a path segment for an array index
+func Index(i int) PathSegment {
+	return PathSegment{Index: i, IsIndex: true}
+}
+
+// Path locates a value inside a nested structure, outermost segment first
+type Path []PathSegment
+
+// String renders the path in dotted notation with bracketed indexes,
+// e.g. "address.postalCode" or "tags[0].name"
+func (p Path) String() string {
+	return p.Dotted()
+}
+
+// Dotted renders the path in dotted notation with bracketed indexes,
+// e.g. "address.postalCode" or "tags[0].name"
+func (p Path) Dotted() string {
+	var sb strings.Builder
+	for i, segment := range p {
+		if segment.IsIndex {
+			sb.WriteString("[" + strconv.Itoa(segment.Index) + "]")
+			continue
+		}
+		if i > 0 {
+			sb.WriteString(".")
+		}
+		sb.WriteString(segment.Key)
+	}
+	return sb.String()
+}
+
+// Bracket renders the path in form-style bracket notation,
+// e.g. "address[postalCode]" or "tags[0][name]"
+func (p Path) Bracket() string {
+	var sb strings.Builder
+	for i, segment := range p {
+		switch {
+		case segment.IsIndex:
+			sb.WriteString("[" + strconv.Itoa(segment.Index) + "]")
+		case i == 0:
+			sb.WriteString(segment.Key)
+		default:
+			sb.WriteString("[" + segment.Key + "]")
+		}
+	}
+	return sb.String()
+}
+
+// JSONPointer renders the path as an RFC 6901 JSON Pointer,
+// e.g. "/address/postalCode" or "/tags/0/name"
+func (p Path) JSONPointer() string {
+	var sb strings.Builder
+	for _, segment := range p {
+		sb.WriteString("/")
+		if segment.IsIndex {
+			sb.WriteString(strconv.Itoa(segment.Index))
+			continue
+		}
+		sb.WriteString(pointerEscaper.Replace(segment.Key))
+	}
+	return sb.String()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestPath_Renderings(t *testing.T) {
+	testCases := []struct {
+		path    Path
+		dotted  string
+		bracket string
+		pointer string
+	}{
+		{nil, "", "", ""},
+		{Path{Key("address"), Key("postalCode")}, "address.postalCode", "address[postalCode]", "/address/postalCode"},
+		{Path{Key("tags"), Index(0), Key("name")}, "tags[0].name", "tags[0][name]", "/tags/0/name"},
+		{Path{Index(1)}, "[1]", "[1]", "/1"},
+		{Path{Index(2), Key("id")}, "[2].id", "[2][id]", "/2/id"},
+		{Path{Key("a/b"), Key("c~d")}, "a/b.c~d", "a/b[c~d]", "/a~1b/c~0d"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.path.Dotted(); got != tc.dotted {
+			t.Errorf("Expected dotted '%s', got '%s'", tc.dotted, got)
+		}
+		if got := tc.path.String(); got != tc.dotted {
+			t.Errorf("Expected String() '%s', got '%s'", tc.dotted, got)
+		}
+		if got := tc.path.Bracket(); got != tc.bracket {
+			t.Errorf("Expected bracket '%s', got '%s'", tc.bracket, got)
+		}
+		if got := tc.path.JSONPointer(); got != tc.pointer {
+			t.Errorf("Expected JSON pointer '%s', got '%s'", tc.pointer, got)
+		}
+	}
+}
+
+func TestPath_NestedObjectErrorsKeepFullPath(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{
+		Schema: map[string]AnyValidator{
+			"address": &ObjectValidator[map[string]any]{
+				Schema: map[string]AnyValidator{
+					"postalCode": (&StringValidator{}).Pattern(`^\d{5}$`),
+				},
+			},
+		},
+	}
+
+	result := validator.Validate(map[string]any{
+		"address": map[string]any{"postalCode": "abc"},
+	})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Field != "address.postalCode" {
+		t.Errorf("Expected field 'address.postalCode', got '%s'", err.Field)
+	}
+	if err.Path.JSONPointer() != "/address/postalCode" {
+		t.Errorf("Expected pointer '/address/postalCode', got '%s'", err.Path.JSONPointer())
+	}
+}
+
+func TestPath_ArrayOfObjects(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{
+		Schema: map[string]AnyValidator{
+			"items": &ArrayValidator[any]{
+				ItemValidator: &ObjectValidator[map[string]any]{
+					Schema: map[string]AnyValidator{"name": &StringValidator{}},
+				},
+			},
+		},
+	}
+
+	result := validator.Validate(map[string]any{
+		"items": []any{
+			map[string]any{"name": "ok"},
+			map[string]any{},
+		},
+	})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Field != "items[1].name" {
+		t.Errorf("Expected field 'items[1].name', got '%s'", err.Field)
+	}
+	if len(err.Path) != 3 || !err.Path[1].IsIndex || err.Path[1].Index != 1 {
+		t.Errorf("Expected structured path [items 1 name], got %+v", err.Path)
+	}
+}
+
+func TestPath_PrefixedKeepsLegacyField(t *testing.T) {
+	// Errors from custom validators may only set Field
+	err := ValidationError{Field: "custom", Message: "bad"}.prefixed(Index(3))
+
+	if err.Field != "[3].custom" {
+		t.Errorf("Expected field '[3].custom', got '%s'", err.Field)
+	}
+	if err.Path.JSONPointer() != "/3/custom" {
+		t.Errorf("Expected pointer '/3/custom', got '%s'", err.Path.JSONPointer())
+	}
+}
@@ -0,0 +1,244 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// RecordValidator validates dictionary-shaped maps, such as metadata or
+// per-locale labels, whose keys are not known in advance. Every key is
+// validated with KeyValidator and every value with ValueValidator; a nil
+// validator accepts anything
+type RecordValidator struct {
+	BaseValidator
+	KeyValidator   AnyValidator
+	ValueValidator AnyValidator
+	minProperties  *int
+	maxProperties  *int
+}
+
+// RecordConstraints describes the constraints configured on a RecordValidator
+type RecordConstraints struct {
+	MinProperties *int
+	MaxProperties *int
+}
+
+// NewRecordValidator creates a validator for maps whose keys match key and
+// whose values match value
+func NewRecordValidator(key AnyValidator, value AnyValidator) *RecordValidator {
+	return &RecordValidator{KeyValidator: key, ValueValidator: value}
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (r *RecordValidator) Constraints() RecordConstraints {
+	return RecordConstraints{
+		MinProperties: copyPointer(r.minProperties),
+		MaxProperties: copyPointer(r.maxProperties),
+	}
+}
+
+func (r *RecordValidator) Validate(value any) ValidationResult {
+	return r.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (r *RecordValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return r.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (r *RecordValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Maps may be passed by pointer; a missing value takes the default
+	value = r.applyDefault(indirect(value))
+
+	// Handle nil values for optional validation
+	if value == nil {
+		if r.isOptional() {
+			return ValidationResult{IsValid: true, Errors: nil}
+		}
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				r.newError(opts, CodeRecordRequired, nil),
+			},
+		}
+	}
+
+	// Check if the value is actually a map
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Map {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				r.newError(opts, CodeRecordType,
+					map[string]any{"expected": "object", "actual": fmt.Sprintf("%T", value)}),
+			},
+		}
+	}
+	var errors []ValidationError
+
+	// Check the number of entries
+	count := reflected.Len()
+	if r.minProperties != nil && count < *r.minProperties {
+		errors = append(errors, r.newError(opts, CodeRecordMinProperties,
+			map[string]any{"min": *r.minProperties, "actual": count}))
+	}
+	if r.maxProperties != nil && count > *r.maxProperties {
+		errors = append(errors, r.newError(opts, CodeRecordMaxProperties,
+			map[string]any{"max": *r.maxProperties, "actual": count}))
+	}
+	if r.shouldAbort(errors) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+
+	// Keys keep their Go type for the key validator and are rendered as
+	// strings for paths and the output map, in sorted order
+	entries := make([]recordEntry, 0, count)
+	for _, key := range reflected.MapKeys() {
+		entries = append(entries, recordEntry{
+			key:   key.Interface(),
+			name:  fmt.Sprintf("%v", key.Interface()),
+			value: reflected.MapIndex(key).Interface(),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+
+	// Validate each key and each value, concurrently on the context path
+	jobs := make([]validationJob, 0, 2*len(entries))
+	for _, entry := range entries {
+		entry := entry
+		jobs = append(jobs,
+			func(opts Options) ValidationResult {
+				if r.KeyValidator == nil {
+					return ValidationResult{IsValid: true, Value: entry.key}
+				}
+				return validateWithOptions(r.KeyValidator, entry.key, opts)
+			},
+			func(opts Options) ValidationResult {
+				if r.ValueValidator == nil {
+					return ValidationResult{IsValid: true, Value: entry.value}
+				}
+				return validateWithOptions(r.ValueValidator, entry.value, opts)
+			})
+	}
+	results := r.validateAll(jobs, opts)
+
+	output := make(map[string]any, len(entries))
+	for i, entry := range entries {
+		keyResult, valueResult := results[2*i], results[2*i+1]
+
+		// Key errors are wrapped so that they are not mistaken for errors
+		// in the value at the same path
+		for _, keyError := range keyResult.Errors {
+			if keyError.Code == CodeCanceled {
+				errors = append(errors, keyError.prefixed(Key(entry.name)))
+				continue
+			}
+			err := r.newError(opts, CodeRecordInvalidKey,
+				map[string]any{"key": entry.name, "code": keyError.Code, "reason": keyError.Message})
+			errors = append(errors, err.prefixed(Key(entry.name)))
+		}
+		for _, valueError := range valueResult.Errors {
+			errors = append(errors, valueError.prefixed(Key(entry.name)))
+		}
+		if !keyResult.IsValid || !valueResult.IsValid {
+			if r.shouldAbort(errors) || isCanceled(keyResult) || isCanceled(valueResult) {
+				return ValidationResult{IsValid: false, Errors: errors}
+			}
+			continue
+		}
+		key := outputOf(keyResult, entry.key)
+		output[fmt.Sprintf("%v", key)] = outputOf(valueResult, entry.value)
+	}
+
+	// Run custom checks once every entry is valid, then transforms
+	if len(errors) == 0 {
+		errors = r.refine(output, errors, opts)
+	}
+	return r.finish(output, errors, opts)
+}
+
+// recordEntry is a map entry with its key rendered for paths
+type recordEntry struct {
+	key   any
+	name  string
+	value any
+}
+
+// MinProperties requires at least count entries
+func (r *RecordValidator) MinProperties(count int) *RecordValidator {
+	r = clone(r)
+	r.minProperties = &count
+	return r
+}
+
+// MaxProperties allows at most count entries
+func (r *RecordValidator) MaxProperties(count int) *RecordValidator {
+	r = clone(r)
+	r.maxProperties = &count
+	return r
+}
+
+// Refine adds a custom check that receives the record as a map[string]any
+// once every entry is valid and returns an error, or nil when the value is
+// acceptable
+func (r *RecordValidator) Refine(check func(value any) *ValidationError) *RecordValidator {
+	r = clone(r)
+	r.addRefinement(check)
+	return r
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (r *RecordValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *RecordValidator {
+	r = clone(r)
+	r.addContextRefinement(check)
+	return r
+}
+
+// Transform adds a function that turns the valid value into the output
+func (r *RecordValidator) Transform(transform func(value any) (any, error)) *RecordValidator {
+	r = clone(r)
+	r.addTransform(transform)
+	return r
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (r *RecordValidator) Default(value map[string]any) *RecordValidator {
+	r = clone(r)
+	r.setDefault(value)
+	return r
+}
+
+// AbortEarly stops validation at the first invalid entry
+func (r *RecordValidator) AbortEarly() *RecordValidator {
+	r = clone(r)
+	r.setAbortEarly(true)
+	return r
+}
+
+// CollectAll reports errors for every invalid entry (the default)
+func (r *RecordValidator) CollectAll() *RecordValidator {
+	r = clone(r)
+	r.setAbortEarly(false)
+	return r
+}
+
+func (r *RecordValidator) Optional() Validator[map[string]any] {
+	r = clone(r)
+	r.setOptional()
+	return r
+}
+
+// Nullable also accepts null, see NullableValidator
+func (r *RecordValidator) Nullable() *NullableValidator {
+	return nullableOf(r, r.optional)
+}
+
+func (r *RecordValidator) WithMessage(message string) Validator[map[string]any] {
+	r = clone(r)
+	r.setMessage(message)
+	return r
+}
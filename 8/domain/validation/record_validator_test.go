@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecordValidator_KeysAndValues(t *testing.T) {
+	labels := NewRecordValidator(
+		(&StringValidator{}).Pattern(`^[a-z]{2}(-[A-Z]{2})?$`),
+		(&StringValidator{}).MinLength(1),
+	)
+
+	if result := labels.Validate(map[string]any{"en": "Books", "pt-BR": "Livros"}); !result.IsValid {
+		t.Errorf("Expected labels to be valid, got %+v", result.Errors)
+	}
+
+	result := labels.Validate(map[string]any{"english": "Books", "pt": ""})
+	if result.IsValid || len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	keyError, valueError := result.Errors[0], result.Errors[1]
+	if keyError.Code != CodeRecordInvalidKey || keyError.Field != "english" || keyError.Params["code"] != CodeStringPattern {
+		t.Errorf("Expected invalid key error at english, got %+v", keyError)
+	}
+	if valueError.Code != CodeStringMinLength || valueError.Field != "pt" {
+		t.Errorf("Expected value error at pt, got %+v", valueError)
+	}
+}
+
+func TestRecordValidator_TypedKeys(t *testing.T) {
+	// Keys keep their Go type instead of being formatted as strings
+	scores := NewRecordValidator((&NumberValidator{}).Int().Min(1), &NumberValidator{})
+
+	if result := scores.Validate(map[int]float64{1: 9.5, 2: 7}); !result.IsValid {
+		t.Errorf("Expected int keys to be valid, got %+v", result.Errors)
+	}
+	result := scores.Validate(map[int]float64{0: 1})
+	if result.IsValid || result.Errors[0].Code != CodeRecordInvalidKey || result.Errors[0].Field != "0" {
+		t.Errorf("Expected invalid key error at 0, got %+v", result.Errors)
+	}
+
+	result = NewRecordValidator(nil, nil).Validate([]any{})
+	if result.IsValid || result.Errors[0].Code != CodeRecordType {
+		t.Errorf("Expected type error, got %+v", result.Errors)
+	}
+}
+
+func TestRecordValidator_PropertyCounts(t *testing.T) {
+	metadata := NewRecordValidator(nil, &StringValidator{}).MinProperties(1).MaxProperties(2)
+
+	result := metadata.Validate(map[string]any{})
+	if result.IsValid || result.Errors[0].Code != CodeRecordMinProperties {
+		t.Errorf("Expected min properties error, got %+v", result.Errors)
+	}
+	result = metadata.Validate(map[string]any{"a": "1", "b": "2", "c": "3"})
+	if result.IsValid || result.Errors[0].Code != CodeRecordMaxProperties {
+		t.Errorf("Expected max properties error, got %+v", result.Errors)
+	}
+	if result := metadata.Validate(map[string]any{"a": "1"}); !result.IsValid {
+		t.Errorf("Expected one entry to be valid, got %+v", result.Errors)
+	}
+}
+
+func TestRecordValidator_Output(t *testing.T) {
+	tags := NewRecordValidator((&StringValidator{}).ToLower(), (&StringValidator{}).Trim())
+
+	result := tags.Validate(map[string]any{"Color": " red "})
+	if !result.IsValid {
+		t.Fatalf("Expected record to be valid, got %+v", result.Errors)
+	}
+	if expected := map[string]any{"color": "red"}; !reflect.DeepEqual(result.Value, expected) {
+		t.Errorf("Expected transformed keys and values %v, got %v", expected, result.Value)
+	}
+}
@@ -0,0 +1,144 @@
+package validation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// String format names. They match the JSON Schema "format" keyword, using
+// the standard names where one exists (e.g. "email", "uri", "date-time")
+const (
+	FormatEmail       = "email"
+	FormatURL         = "uri"
+	FormatUUID        = "uuid"
+	FormatIPv4        = "ipv4"
+	FormatIPv6        = "ipv6"
+	FormatCIDR        = "cidr"
+	FormatHostname    = "hostname"
+	FormatISODate     = "date"
+	FormatISODateTime = "date-time"
+	FormatSemver      = "semver"
+	FormatBase64      = "base64"
+	FormatHex         = "hex"
+	FormatJSON        = "json"
+)
+
+// stringFormat checks a string against a named format
+type stringFormat struct {
+	code  string
+	check func(s *StringValidator, value string) bool
+}
+
+var stringFormats = map[string]stringFormat{
+	FormatEmail:       {CodeStringEmail, func(_ *StringValidator, value string) bool { return emailRegex.MatchString(value) }},
+	FormatURL:         {CodeStringURL, (*StringValidator).isURL},
+	FormatUUID:        {CodeStringUUID, (*StringValidator).isUUID},
+	FormatIPv4:        {CodeStringIPv4, func(_ *StringValidator, value string) bool { return isIP(value, false) }},
+	FormatIPv6:        {CodeStringIPv6, func(_ *StringValidator, value string) bool { return isIP(value, true) }},
+	FormatCIDR:        {CodeStringCIDR, func(_ *StringValidator, value string) bool { return isCIDR(value) }},
+	FormatHostname:    {CodeStringHostname, func(_ *StringValidator, value string) bool { return isHostname(value) }},
+	FormatISODate:     {CodeStringISODate, func(_ *StringValidator, value string) bool { return isTime(value, time.DateOnly) }},
+	FormatISODateTime: {CodeStringISODateTime, func(_ *StringValidator, value string) bool { return isTime(value, time.RFC3339Nano) }},
+	FormatSemver:      {CodeStringSemver, func(_ *StringValidator, value string) bool { return semverRegex.MatchString(value) }},
+	FormatBase64:      {CodeStringBase64, func(_ *StringValidator, value string) bool { return isBase64(value) }},
+	FormatHex:         {CodeStringHex, func(_ *StringValidator, value string) bool { return hexRegex.MatchString(value) }},
+	FormatJSON:        {CodeStringJSON, func(_ *StringValidator, value string) bool { return json.Valid([]byte(value)) }},
+}
+
+// IsStringFormat reports whether name is a format StringValidator.Format
+// understands
+func IsStringFormat(name string) bool {
+	_, ok := stringFormats[name]
+	return ok
+}
+
+var (
+	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$`)
+	uuidRegex  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// semverRegex is the regular expression suggested by semver.org
+	semverRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+	hexRegex    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	labelRegex  = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+)
+
+// isURL accepts absolute URLs with a host, restricted to the allowed
+// schemes when any are configured
+func (s *StringValidator) isURL(value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return false
+	}
+	if len(s.urlSchemes) == 0 {
+		return true
+	}
+	for _, scheme := range s.urlSchemes {
+		if strings.EqualFold(parsed.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUUID accepts RFC 4122 UUIDs, restricted to the allowed versions when
+// any are configured
+func (s *StringValidator) isUUID(value string) bool {
+	if !uuidRegex.MatchString(value) {
+		return false
+	}
+	if len(s.uuidVersions) == 0 {
+		return true
+	}
+	version, _ := strconv.ParseInt(value[14:15], 16, 0)
+	for _, allowed := range s.uuidVersions {
+		if int(version) == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func isIP(value string, v6 bool) bool {
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.Zone() != "" {
+		return false
+	}
+	if v6 {
+		return addr.Is6()
+	}
+	return addr.Is4()
+}
+
+func isCIDR(value string) bool {
+	_, err := netip.ParsePrefix(value)
+	return err == nil
+}
+
+// isHostname accepts RFC 1123 host names
+func isHostname(value string) bool {
+	if len(value) == 0 || len(value) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(value, ".") {
+		if !labelRegex.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTime(value, layout string) bool {
+	_, err := time.Parse(layout, value)
+	return err == nil
+}
+
+// isBase64 accepts standard, padded base64
+func isBase64(value string) bool {
+	_, err := base64.StdEncoding.Strict().DecodeString(value)
+	return err == nil
+}
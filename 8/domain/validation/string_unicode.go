@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// LengthUnit selects how MinLength and MaxLength count a string
+type LengthUnit int
+
+const (
+	// Bytes counts UTF-8 bytes, so "José" has length 5 (the default)
+	Bytes LengthUnit = iota
+	// Runes counts Unicode code points, like JSON Schema's minLength and
+	// maxLength, so "José" has length 4
+	Runes
+	// Graphemes counts user-perceived characters, so a family emoji made of
+	// several code points has length 1
+	Graphemes
+)
+
+func (u LengthUnit) String() string {
+	switch u {
+	case Runes:
+		return "runes"
+	case Graphemes:
+		return "graphemes"
+	default:
+		return "bytes"
+	}
+}
+
+// count returns the length of value in the unit
+func (u LengthUnit) count(value string) int {
+	switch u {
+	case Runes:
+		return utf8.RuneCountInString(value)
+	case Graphemes:
+		return uniseg.GraphemeClusterCount(value)
+	default:
+		return len(value)
+	}
+}
+
+// isBlank reports whether value holds nothing but Unicode whitespace and
+// invisible format characters such as zero-width spaces
+func isBlank(value string) bool {
+	for _, r := range value {
+		if !unicode.IsSpace(r) && !unicode.Is(unicode.Cf, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeNFC and normalizeNFKC are StringValidator normalizers
+func normalizeNFC(value string) string {
+	return norm.NFC.String(value)
+}
+
+func normalizeNFKC(value string) string {
+	return norm.NFKC.String(value)
+}
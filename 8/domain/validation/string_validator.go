@@ -1,20 +1,80 @@
 package validation
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 // StringValidator validates string values
 type StringValidator struct {
 	BaseValidator
-	minLength *int
-	maxLength *int
-	pattern   *regexp.Regexp
+	minLength    *int
+	maxLength    *int
+	pattern      *regexp.Regexp
+	format       string
+	urlSchemes   []string
+	uuidVersions []int
+	lengthUnit   LengthUnit
+	nonEmpty     bool
+	notBlank     bool
+	coerce       bool
+	normalizers  []func(string) string
+}
+
+// StringConstraints describes the constraints configured on a StringValidator
+type StringConstraints struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   string
+	// Format is the JSON Schema format name, e.g. "email" or "uuid"
+	Format string
+	// URLSchemes restricts the "uri" format to these schemes
+	URLSchemes []string
+	// UUIDVersions restricts the "uuid" format to these versions
+	UUIDVersions []int
+	// LengthUnit is how MinLength and MaxLength count the string
+	LengthUnit LengthUnit
+	NonEmpty   bool
+	NotBlank   bool
+}
+
+// Constraints returns a copy of the configured constraints, e.g. for schema export
+func (s *StringValidator) Constraints() StringConstraints {
+	constraints := StringConstraints{
+		MinLength:    copyPointer(s.minLength),
+		MaxLength:    copyPointer(s.maxLength),
+		Format:       s.format,
+		URLSchemes:   append([]string(nil), s.urlSchemes...),
+		UUIDVersions: append([]int(nil), s.uuidVersions...),
+		LengthUnit:   s.lengthUnit,
+		NonEmpty:     s.nonEmpty,
+		NotBlank:     s.notBlank,
+	}
+	if s.pattern != nil {
+		constraints.Pattern = s.pattern.String()
+	}
+	return constraints
 }
 
 func (s *StringValidator) Validate(value any) ValidationResult {
+	return s.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (s *StringValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return s.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (s *StringValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = s.applyDefault(value)
+
 	// Handle nil values for optional validation
 	if value == nil {
 		if s.isOptional() {
@@ -23,94 +83,323 @@ func (s *StringValidator) Validate(value any) ValidationResult {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: s.getMessage("String value is required"),
-				},
+				s.newError(opts, CodeStringRequired, nil),
 			},
 		}
 	}
 
+	// Convert numbers and booleans when coercion is enabled
+	if s.coerce {
+		value = coerceString(value)
+	}
+
 	// Check if the value is actually a string
 	if reflect.TypeOf(value).Kind() != reflect.String {
 		return ValidationResult{
 			IsValid: false,
 			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: s.getMessage(fmt.Sprintf("Expected string value, got %T", value)),
-				},
+				s.newError(opts, CodeStringType,
+					map[string]any{"expected": "string", "actual": fmt.Sprintf("%T", value)}),
 			},
 		}
 	}
 
-	strValue := value.(string)
+	// Apply Trim, ToLower and similar steps before checking constraints
+	strValue := reflect.ValueOf(value).String()
+	for _, normalize := range s.normalizers {
+		strValue = normalize(strValue)
+	}
+	if len(s.normalizers) > 0 {
+		value = strValue
+	}
+	var errors []ValidationError
+
+	// Check empty and blank constraints; a blank string is reported once
+	if s.notBlank && isBlank(strValue) {
+		errors = append(errors, s.newError(opts, CodeStringBlank, nil))
+	} else if s.nonEmpty && strValue == "" {
+		errors = append(errors, s.newError(opts, CodeStringEmpty, nil))
+	}
+	if s.shouldAbort(errors) {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
 
 	// Check min length constraint
-	if s.minLength != nil && len(strValue) < *s.minLength {
-		return ValidationResult{
-			IsValid: false,
-			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: s.getMessage(fmt.Sprintf("String must be at least %d characters long", *s.minLength)),
-				},
-			},
+	length := s.lengthUnit.count(strValue)
+	if s.minLength != nil && length < *s.minLength {
+		errors = append(errors, s.newError(opts, CodeStringMinLength,
+			map[string]any{"min": *s.minLength, "actual": length}))
+		if s.shouldAbort(errors) {
+			return ValidationResult{IsValid: false, Errors: errors}
 		}
 	}
 
 	// Check max length constraint
-	if s.maxLength != nil && len(strValue) > *s.maxLength {
-		return ValidationResult{
-			IsValid: false,
-			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: s.getMessage(fmt.Sprintf("String must be at most %d characters long", *s.maxLength)),
-				},
-			},
+	if s.maxLength != nil && length > *s.maxLength {
+		errors = append(errors, s.newError(opts, CodeStringMaxLength,
+			map[string]any{"max": *s.maxLength, "actual": length}))
+		if s.shouldAbort(errors) {
+			return ValidationResult{IsValid: false, Errors: errors}
 		}
 	}
 
 	// Check pattern constraint
 	if s.pattern != nil && !s.pattern.MatchString(strValue) {
-		return ValidationResult{
-			IsValid: false,
-			Errors: []ValidationError{
-				{
-					Field:   "",
-					Message: s.getMessage(fmt.Sprintf("String must match pattern: %s", s.pattern.String())),
-				},
-			},
+		errors = append(errors, s.newError(opts, CodeStringPattern,
+			map[string]any{"pattern": s.pattern.String()}))
+		if s.shouldAbort(errors) {
+			return ValidationResult{IsValid: false, Errors: errors}
 		}
 	}
 
-	// Value is valid
-	return ValidationResult{IsValid: true, Errors: nil}
+	// Check format constraint
+	if s.format != "" && !stringFormats[s.format].check(s, strValue) {
+		errors = append(errors, s.newError(opts, stringFormats[s.format].code, s.formatParams()))
+	}
+
+	// Run custom checks, then transforms
+	errors = s.refine(strValue, errors, opts)
+	return s.finish(value, errors, opts)
 }
 
 func (s *StringValidator) MinLength(length int) *StringValidator {
+	s = clone(s)
 	s.minLength = &length
 	return s
 }
 
 func (s *StringValidator) MaxLength(length int) *StringValidator {
+	s = clone(s)
 	s.maxLength = &length
 	return s
 }
 
+// LengthIn sets how MinLength and MaxLength count the string. The default
+// is Bytes; use Runes or Graphemes for user-facing text such as names
+func (s *StringValidator) LengthIn(unit LengthUnit) *StringValidator {
+	s = clone(s)
+	s.lengthUnit = unit
+	return s
+}
+
+// NonEmpty rejects the empty string
+func (s *StringValidator) NonEmpty() *StringValidator {
+	s = clone(s)
+	s.nonEmpty = true
+	return s
+}
+
+// NotBlank rejects strings made only of Unicode whitespace, including
+// non-breaking and zero-width spaces
+func (s *StringValidator) NotBlank() *StringValidator {
+	s = clone(s)
+	s.notBlank = true
+	return s
+}
+
 func (s *StringValidator) Pattern(pattern string) *StringValidator {
+	s = clone(s)
 	regex := regexp.MustCompile(pattern)
 	s.pattern = regex
 	return s
 }
 
+// Format checks the string against a named format, one of the Format*
+// constants. It panics on unknown names, like Pattern does on invalid
+// expressions
+func (s *StringValidator) Format(name string) *StringValidator {
+	s = clone(s)
+	if !IsStringFormat(name) {
+		panic(fmt.Sprintf("validation: unknown string format %q", name))
+	}
+	s.format = name
+	return s
+}
+
+// Email requires an email address such as "john@example.com"
+func (s *StringValidator) Email() *StringValidator {
+	return s.Format(FormatEmail)
+}
+
+// URL requires an absolute URL with a host. When schemes are given, only
+// those are accepted, e.g. URL("https")
+func (s *StringValidator) URL(schemes ...string) *StringValidator {
+	s = clone(s)
+	s.urlSchemes = append([]string(nil), schemes...)
+	return s.Format(FormatURL)
+}
+
+// UUID requires an RFC 4122 UUID. When versions are given, only those are
+// accepted, e.g. UUID(4)
+func (s *StringValidator) UUID(versions ...int) *StringValidator {
+	s = clone(s)
+	s.uuidVersions = append([]int(nil), versions...)
+	return s.Format(FormatUUID)
+}
+
+// IPv4 requires a dotted-decimal IPv4 address
+func (s *StringValidator) IPv4() *StringValidator {
+	return s.Format(FormatIPv4)
+}
+
+// IPv6 requires an IPv6 address without a zone
+func (s *StringValidator) IPv6() *StringValidator {
+	return s.Format(FormatIPv6)
+}
+
+// CIDR requires an IPv4 or IPv6 prefix such as "10.0.0.0/8"
+func (s *StringValidator) CIDR() *StringValidator {
+	return s.Format(FormatCIDR)
+}
+
+// Hostname requires an RFC 1123 host name
+func (s *StringValidator) Hostname() *StringValidator {
+	return s.Format(FormatHostname)
+}
+
+// ISODate requires a calendar date such as "2024-01-31"
+func (s *StringValidator) ISODate() *StringValidator {
+	return s.Format(FormatISODate)
+}
+
+// ISODateTime requires an RFC 3339 date-time such as "2024-01-31T10:00:00Z"
+func (s *StringValidator) ISODateTime() *StringValidator {
+	return s.Format(FormatISODateTime)
+}
+
+// Semver requires a semantic version such as "1.2.3-beta.1"
+func (s *StringValidator) Semver() *StringValidator {
+	return s.Format(FormatSemver)
+}
+
+// Base64 requires standard, padded base64
+func (s *StringValidator) Base64() *StringValidator {
+	return s.Format(FormatBase64)
+}
+
+// Hex requires a non-empty string of hexadecimal digits
+func (s *StringValidator) Hex() *StringValidator {
+	return s.Format(FormatHex)
+}
+
+// JSON requires a valid JSON document
+func (s *StringValidator) JSON() *StringValidator {
+	return s.Format(FormatJSON)
+}
+
+func (s *StringValidator) formatParams() map[string]any {
+	params := map[string]any{"format": s.format}
+	if len(s.urlSchemes) > 0 {
+		params["schemes"] = strings.Join(s.urlSchemes, ", ")
+	}
+	if len(s.uuidVersions) > 0 {
+		versions := make([]string, len(s.uuidVersions))
+		for i, version := range s.uuidVersions {
+			versions[i] = strconv.Itoa(version)
+		}
+		params["versions"] = strings.Join(versions, ", ")
+	}
+	return params
+}
+
+// Refine adds a custom check that receives the string once it passed the
+// type check and returns an error, or nil when the value is acceptable
+func (s *StringValidator) Refine(check func(value any) *ValidationError) *StringValidator {
+	s = clone(s)
+	s.addRefinement(check)
+	return s
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (s *StringValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *StringValidator {
+	s = clone(s)
+	s.addContextRefinement(check)
+	return s
+}
+
+// Coerce converts numbers and booleans to strings before validation, e.g.
+// 42 becomes "42"
+func (s *StringValidator) Coerce() *StringValidator {
+	s = clone(s)
+	s.coerce = true
+	return s
+}
+
+// Trim removes leading and trailing whitespace before the constraints are
+// checked; the trimmed string is the output value
+func (s *StringValidator) Trim() *StringValidator {
+	s = clone(s)
+	s.normalizers = appendCopy(s.normalizers, strings.TrimSpace)
+	return s
+}
+
+// ToLower lowercases the string before the constraints are checked; the
+// lowercased string is the output value
+func (s *StringValidator) ToLower() *StringValidator {
+	s = clone(s)
+	s.normalizers = appendCopy(s.normalizers, strings.ToLower)
+	return s
+}
+
+// NFC applies Unicode canonical composition before the constraints are
+// checked, so "e" followed by a combining accent matches "é"; the
+// normalized string is the output value
+func (s *StringValidator) NFC() *StringValidator {
+	s = clone(s)
+	s.normalizers = appendCopy(s.normalizers, normalizeNFC)
+	return s
+}
+
+// NFKC applies Unicode compatibility composition before the constraints are
+// checked, e.g. full-width "Ａ" becomes "A" and "ﬁ" becomes "fi"; the
+// normalized string is the output value
+func (s *StringValidator) NFKC() *StringValidator {
+	s = clone(s)
+	s.normalizers = appendCopy(s.normalizers, normalizeNFKC)
+	return s
+}
+
+// Transform adds a function that turns the valid value into the output
+func (s *StringValidator) Transform(transform func(value any) (any, error)) *StringValidator {
+	s = clone(s)
+	s.addTransform(transform)
+	return s
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (s *StringValidator) Default(value string) *StringValidator {
+	s = clone(s)
+	s.setDefault(value)
+	return s
+}
+
+// AbortEarly stops validation at the first failed constraint
+func (s *StringValidator) AbortEarly() *StringValidator {
+	s = clone(s)
+	s.setAbortEarly(true)
+	return s
+}
+
+// CollectAll reports every failed constraint (the default)
+func (s *StringValidator) CollectAll() *StringValidator {
+	s = clone(s)
+	s.setAbortEarly(false)
+	return s
+}
+
 func (s *StringValidator) Optional() Validator[string] {
+	s = clone(s)
 	s.setOptional()
 	return s
 }
 
+// Nullable also accepts null, see NullableValidator
+func (s *StringValidator) Nullable() *NullableValidator {
+	return nullableOf(s, s.optional)
+}
+
 func (s *StringValidator) WithMessage(message string) Validator[string] {
+	s = clone(s)
 	s.setMessage(message)
 	return s
 }
@@ -1,6 +1,9 @@
 package validation
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -63,7 +66,7 @@ func TestStringValidator_ValidateNilOptional(t *testing.T) {
 	validator := &StringValidator{}
 
 	// Test with nil value (optional)
-	validator.Optional()
+	validator = validator.Optional().(*StringValidator)
 	result := validator.Validate(nil)
 	if !result.IsValid {
 		t.Error("String validator should accept nil value when optional")
@@ -77,7 +80,7 @@ func TestStringValidator_MinLength(t *testing.T) {
 	validator := &StringValidator{}
 	minLength := 5
 
-	validator.MinLength(minLength)
+	validator = validator.MinLength(minLength)
 
 	if validator.minLength == nil {
 		t.Error("MinLength should set the minLength field")
@@ -110,7 +113,7 @@ func TestStringValidator_MaxLength(t *testing.T) {
 	validator := &StringValidator{}
 	maxLength := 10
 
-	validator.MaxLength(maxLength)
+	validator = validator.MaxLength(maxLength)
 
 	if validator.maxLength == nil {
 		t.Error("MaxLength should set the maxLength field")
@@ -143,7 +146,7 @@ func TestStringValidator_Pattern(t *testing.T) {
 	validator := &StringValidator{}
 	pattern := `^[a-z]+$`
 
-	validator.Pattern(pattern)
+	validator = validator.Pattern(pattern)
 
 	if validator.pattern == nil {
 		t.Error("Pattern should set the pattern field")
@@ -176,7 +179,7 @@ func TestStringValidator_Pattern(t *testing.T) {
 
 func TestStringValidator_CombinedConstraints(t *testing.T) {
 	validator := &StringValidator{}
-	validator.MinLength(3).MaxLength(10).Pattern(`^[a-z]+$`)
+	validator = validator.MinLength(3).MaxLength(10).Pattern(`^[a-z]+$`)
 
 	// Test valid string
 	result := validator.Validate("hello") // 5 chars, lowercase
@@ -213,7 +216,7 @@ func TestStringValidator_EmptyString(t *testing.T) {
 	}
 
 	// Test empty string with min length constraint
-	validator.MinLength(1)
+	validator = validator.MinLength(1)
 	result = validator.Validate("")
 	if result.IsValid {
 		t.Error("String validator should reject empty string when min length > 0")
@@ -225,13 +228,17 @@ func TestStringValidator_Optional(t *testing.T) {
 
 	result := validator.Optional()
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Optional() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Optional() should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Optional() should not change the receiver")
+	}
+	copied := result.(*StringValidator)
 
 	// Should be marked as optional
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be marked as optional after Optional() call")
 	}
 }
@@ -242,13 +249,17 @@ func TestStringValidator_WithMessage(t *testing.T) {
 
 	result := validator.WithMessage(customMessage)
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("WithMessage() should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("WithMessage() should return a new validator instance")
+	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("WithMessage() should not change the receiver")
 	}
+	copied := result.(*StringValidator)
 
 	// Should have the custom message
-	if validator.getMessage("default") != customMessage {
+	if copied.getMessage("default") != customMessage {
 		t.Error("Validator should have the custom message after WithMessage() call")
 	}
 }
@@ -257,7 +268,7 @@ func TestStringValidator_WithMessageValidation(t *testing.T) {
 	validator := &StringValidator{}
 	customMessage := "Custom string validation message"
 
-	validator.WithMessage(customMessage)
+	validator = validator.WithMessage(customMessage).(*StringValidator)
 
 	// Test that custom message is used for invalid type
 	result := validator.Validate(42)
@@ -283,25 +294,29 @@ func TestStringValidator_Chaining(t *testing.T) {
 		Optional().
 		WithMessage("Custom message")
 
-	// Should return the same validator
-	if result != validator {
-		t.Error("Method chaining should return the same validator instance")
+	// Should return a copy and leave the receiver unchanged
+	if result == validator {
+		t.Error("Method chaining should return a new validator instance")
 	}
+	if validator.isOptional() || validator.getMessage("default") != "default" {
+		t.Error("Method chaining should not change the receiver")
+	}
+	copied := result.(*StringValidator)
 
 	// Should have all properties set
-	if validator.minLength == nil || *validator.minLength != 3 {
+	if copied.minLength == nil || *copied.minLength != 3 {
 		t.Error("MinLength should be set to 3")
 	}
-	if validator.maxLength == nil || *validator.maxLength != 10 {
+	if copied.maxLength == nil || *copied.maxLength != 10 {
 		t.Error("MaxLength should be set to 10")
 	}
-	if validator.pattern == nil {
+	if copied.pattern == nil {
 		t.Error("Pattern should be set")
 	}
-	if !validator.isOptional() {
+	if !copied.isOptional() {
 		t.Error("Validator should be optional")
 	}
-	if validator.getMessage("default") != "Custom message" {
+	if copied.getMessage("default") != "Custom message" {
 		t.Error("Validator should have custom message")
 	}
 }
@@ -369,7 +384,7 @@ func TestStringValidator_ErrorMessages(t *testing.T) {
 	}
 
 	// Test min length error message
-	validator.MinLength(5)
+	validator = validator.MinLength(5)
 	result = validator.Validate("hi")
 	if result.IsValid {
 		t.Error("String validator should reject short string")
@@ -383,7 +398,7 @@ func TestStringValidator_ErrorMessages(t *testing.T) {
 	}
 
 	// Test max length error message
-	validator.MaxLength(10)
+	validator = validator.MaxLength(10)
 	result = validator.Validate("helloworld123")
 	if result.IsValid {
 		t.Error("String validator should reject long string")
@@ -397,7 +412,7 @@ func TestStringValidator_ErrorMessages(t *testing.T) {
 	}
 
 	// Test pattern error message
-	validator.Pattern(`^[a-z]+$`)
+	validator = validator.Pattern(`^[a-z]+$`)
 	result = validator.Validate("HELLO")
 	if result.IsValid {
 		t.Error("String validator should reject string not matching pattern")
@@ -415,7 +430,7 @@ func TestStringValidator_ComplexPatterns(t *testing.T) {
 	validator := &StringValidator{}
 
 	// Test email pattern
-	validator.Pattern(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	validator = validator.Pattern(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
 	validEmails := []string{
 		"test@example.com",
@@ -469,3 +484,349 @@ func TestStringValidator_EdgeCases(t *testing.T) {
 		t.Error("String validator should accept unicode string")
 	}
 }
+
+func TestStringValidator_CollectsAllErrors(t *testing.T) {
+	validator := &StringValidator{}
+	validator = validator.MinLength(5).Pattern(`^[a-z]+$`)
+
+	// Too short and not matching pattern
+	result := validator.Validate("AB")
+	if result.IsValid {
+		t.Error("String validator should reject string failing several constraints")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %d: %+v", len(result.Errors), result.Errors)
+	}
+	if result.Errors[0].Message != "String must be at least 5 characters long" {
+		t.Errorf("Unexpected first error message '%s'", result.Errors[0].Message)
+	}
+	if result.Errors[1].Message != "String must match pattern: ^[a-z]+$" {
+		t.Errorf("Unexpected second error message '%s'", result.Errors[1].Message)
+	}
+}
+
+func TestStringValidator_AbortEarly(t *testing.T) {
+	validator := &StringValidator{}
+	validator = validator.MinLength(5).Pattern(`^[a-z]+$`).AbortEarly()
+
+	result := validator.Validate("AB")
+	if result.IsValid {
+		t.Error("String validator should reject string failing several constraints")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error in abort-early mode, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Message != "String must be at least 5 characters long" {
+		t.Errorf("Expected first failed constraint to be reported, got '%s'", result.Errors[0].Message)
+	}
+
+	// Switching back to collect-all mode reports every failure
+	validator = validator.CollectAll()
+	result = validator.Validate("AB")
+	if len(result.Errors) != 2 {
+		t.Errorf("Expected 2 errors in collect-all mode, got %d", len(result.Errors))
+	}
+}
+
+func TestStringValidator_Constraints(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(2).MaxLength(5).Pattern(`^[a-z]+$`)
+
+	constraints := validator.Constraints()
+	if constraints.MinLength == nil || *constraints.MinLength != 2 {
+		t.Error("Constraints should expose minLength")
+	}
+	if constraints.MaxLength == nil || *constraints.MaxLength != 5 {
+		t.Error("Constraints should expose maxLength")
+	}
+	if constraints.Pattern != `^[a-z]+$` {
+		t.Errorf("Constraints should expose pattern, got '%s'", constraints.Pattern)
+	}
+
+	// Changing the returned copy must not affect the validator
+	*constraints.MinLength = 100
+	if *validator.minLength != 2 {
+		t.Error("Constraints should return a copy")
+	}
+}
+
+type namedString string
+
+func TestStringValidator_NamedStringType(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(3)
+
+	if result := validator.Validate(namedString("hello")); !result.IsValid {
+		t.Error("String validator should accept named string types")
+	}
+	if result := validator.Validate(namedString("hi")); result.IsValid {
+		t.Error("String validator should apply constraints to named string types")
+	}
+}
+
+func TestStringValidator_Refine(t *testing.T) {
+	validator := (&StringValidator{}).MinLength(8).Refine(func(value any) *ValidationError {
+		if !strings.ContainsAny(value.(string), "0123456789") {
+			return &ValidationError{Message: "Password must contain a digit"}
+		}
+		return nil
+	})
+
+	if result := validator.Validate("secret123"); !result.IsValid {
+		t.Errorf("Expected refined value to be valid, got %+v", result.Errors)
+	}
+
+	// Custom checks run alongside the built-in constraints
+	result := validator.Validate("short")
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	refineError := result.Errors[1]
+	if refineError.Code != CodeCustom || refineError.Message != "Password must contain a digit" {
+		t.Errorf("Expected custom error, got %+v", refineError)
+	}
+
+	// Abort-early mode skips custom checks after a failed constraint
+	validator = validator.AbortEarly()
+	if result := validator.Validate("short"); len(result.Errors) != 1 {
+		t.Errorf("Expected 1 error in abort-early mode, got %+v", result.Errors)
+	}
+
+	// Type errors are reported before custom checks see the value
+	if result := validator.Validate(42); result.Errors[0].Code != CodeStringType {
+		t.Errorf("Expected type error, got %+v", result.Errors)
+	}
+}
+
+func TestStringValidator_RefineDefaultMessage(t *testing.T) {
+	validator := (&StringValidator{}).Refine(func(value any) *ValidationError {
+		return &ValidationError{Code: "string.reserved"}
+	}).Refine(func(value any) *ValidationError {
+		return &ValidationError{}
+	})
+
+	result := validator.ValidateWithOptions("admin", Options{Locale: "pt"})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", result.Errors)
+	}
+	// Unknown codes fall back to the code itself
+	if result.Errors[0].Message != "string.reserved" {
+		t.Errorf("Expected code as message, got %q", result.Errors[0].Message)
+	}
+	if result.Errors[1].Code != CodeCustom || result.Errors[1].Message != "Valor inválido" {
+		t.Errorf("Expected translated custom error, got %+v", result.Errors[1])
+	}
+}
+
+func TestStringValidator_Transforms(t *testing.T) {
+	validator := (&StringValidator{}).Trim().ToLower().MinLength(3)
+
+	result := validator.Validate("  John@Example.COM ")
+	if !result.IsValid || result.Value != "john@example.com" {
+		t.Errorf("Expected trimmed lowercase output, got %+v", result)
+	}
+
+	// Constraints see the trimmed value
+	if result := validator.Validate("  ab  "); result.IsValid {
+		t.Error("String validator should check constraints after trimming")
+	}
+
+	// Without transforms the input is passed through unchanged
+	type Name string
+	if result := (&StringValidator{}).Validate(Name("John")); result.Value != Name("John") {
+		t.Errorf("Expected input as output, got %#v", result.Value)
+	}
+}
+
+func TestStringValidator_Transform(t *testing.T) {
+	validator := (&StringValidator{}).Trim().Transform(func(value any) (any, error) {
+		return strings.Split(value.(string), ","), nil
+	})
+
+	result := validator.Validate(" a,b ")
+	if !result.IsValid || !reflect.DeepEqual(result.Value, []string{"a", "b"}) {
+		t.Errorf("Expected split output, got %+v", result)
+	}
+
+	failing := (&StringValidator{}).Transform(func(value any) (any, error) {
+		return nil, errors.New("unsupported")
+	})
+	result = failing.Validate("x")
+	if result.IsValid || result.Errors[0].Code != CodeTransform || result.Value != nil {
+		t.Errorf("Expected transform error, got %+v", result)
+	}
+}
+
+func TestStringValidator_CoerceAndDefault(t *testing.T) {
+	validator := (&StringValidator{}).Coerce()
+	for input, expected := range map[any]string{42: "42", 2.5: "2.5", true: "true", "x": "x"} {
+		if result := validator.Validate(input); !result.IsValid || result.Value != expected {
+			t.Errorf("Expected %v to coerce to %q, got %+v", input, expected, result)
+		}
+	}
+
+	defaulted := (&StringValidator{}).MinLength(2).Default("en")
+	if !defaulted.IsOptional() {
+		t.Error("String validator with a default should be optional")
+	}
+	if result := defaulted.Validate(nil); !result.IsValid || result.Value != "en" {
+		t.Errorf("Expected default output, got %+v", result)
+	}
+}
+
+func TestStringValidator_Formats(t *testing.T) {
+	testCases := []struct {
+		name      string
+		validator *StringValidator
+		code      string
+		valid     []string
+		invalid   []string
+	}{
+		{"email", (&StringValidator{}).Email(), CodeStringEmail,
+			[]string{"john@example.com", "a.b+tag@sub.example.org"},
+			[]string{"john", "john@", "@example.com", "john@example", "jo hn@example.com"}},
+		{"url", (&StringValidator{}).URL(), CodeStringURL,
+			[]string{"https://example.com/path?q=1", "ftp://files.example.com"},
+			[]string{"example.com", "/relative/path", "https://"}},
+		{"uuid", (&StringValidator{}).UUID(), CodeStringUUID,
+			[]string{"123e4567-e89b-12d3-a456-426614174000", "9F2B5C1E-8A4D-4F6B-9C3A-2D1E0F7A6B5C"},
+			[]string{"123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g"}},
+		{"ipv4", (&StringValidator{}).IPv4(), CodeStringIPv4,
+			[]string{"192.168.0.1", "0.0.0.0"},
+			[]string{"256.0.0.1", "192.168.0", "::1"}},
+		{"ipv6", (&StringValidator{}).IPv6(), CodeStringIPv6,
+			[]string{"::1", "2001:db8::8a2e:370:7334"},
+			[]string{"192.168.0.1", "fe80::1%eth0", "2001:db8::g"}},
+		{"cidr", (&StringValidator{}).CIDR(), CodeStringCIDR,
+			[]string{"10.0.0.0/8", "2001:db8::/32"},
+			[]string{"10.0.0.0", "10.0.0.0/33"}},
+		{"hostname", (&StringValidator{}).Hostname(), CodeStringHostname,
+			[]string{"localhost", "api.example.com"},
+			[]string{"-bad.example.com", "exa_mple.com", "example..com"}},
+		{"date", (&StringValidator{}).ISODate(), CodeStringISODate,
+			[]string{"2024-02-29"},
+			[]string{"2023-02-29", "2024-1-5", "2024-01-31T10:00:00Z"}},
+		{"date-time", (&StringValidator{}).ISODateTime(), CodeStringISODateTime,
+			[]string{"2024-01-31T10:00:00Z", "2024-01-31T10:00:00.123+02:00"},
+			[]string{"2024-01-31", "2024-01-31 10:00:00"}},
+		{"semver", (&StringValidator{}).Semver(), CodeStringSemver,
+			[]string{"1.2.3", "1.0.0-beta.1+build.5"},
+			[]string{"1.2", "01.2.3", "v1.2.3"}},
+		{"base64", (&StringValidator{}).Base64(), CodeStringBase64,
+			[]string{"aGVsbG8=", ""},
+			[]string{"aGVsbG8", "not base64!"}},
+		{"hex", (&StringValidator{}).Hex(), CodeStringHex,
+			[]string{"deadBEEF", "0123"},
+			[]string{"", "0x12", "xyz"}},
+		{"json", (&StringValidator{}).JSON(), CodeStringJSON,
+			[]string{`{"a":[1,2]}`, "null"},
+			[]string{"{a:1}", ""}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, value := range tc.valid {
+				if result := tc.validator.Validate(value); !result.IsValid {
+					t.Errorf("Expected %q to be valid, got %+v", value, result.Errors)
+				}
+			}
+			for _, value := range tc.invalid {
+				result := tc.validator.Validate(value)
+				if result.IsValid || result.Errors[0].Code != tc.code {
+					t.Errorf("Expected %q to fail with %s, got %+v", value, tc.code, result.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestStringValidator_FormatOptions(t *testing.T) {
+	secure := (&StringValidator{}).URL("https")
+	if result := secure.Validate("https://example.com"); !result.IsValid {
+		t.Errorf("Expected https URL to be valid, got %+v", result.Errors)
+	}
+	result := secure.Validate("http://example.com")
+	if result.IsValid || result.Errors[0].Params["schemes"] != "https" {
+		t.Errorf("Expected scheme error, got %+v", result.Errors)
+	}
+
+	v4 := (&StringValidator{}).UUID(4)
+	if result := v4.Validate("9f2b5c1e-8a4d-4f6b-9c3a-2d1e0f7a6b5c"); !result.IsValid {
+		t.Errorf("Expected version 4 UUID to be valid, got %+v", result.Errors)
+	}
+	result = v4.Validate("123e4567-e89b-12d3-a456-426614174000")
+	if result.IsValid || result.Errors[0].Code != CodeStringUUID || result.Errors[0].Params["versions"] != "4" {
+		t.Errorf("Expected version error, got %+v", result.Errors)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Format to panic on an unknown name")
+		}
+	}()
+	(&StringValidator{}).Format("credit-card")
+}
+
+func TestStringValidator_LengthUnits(t *testing.T) {
+	family := "\U0001F468\u200d\U0001F469\u200d\U0001F467" // three emoji joined into one grapheme
+	testCases := []struct {
+		unit     LengthUnit
+		value    string
+		expected int
+	}{
+		{Bytes, "José", 5},
+		{Runes, "José", 4},
+		{Graphemes, "José", 4},
+		{Runes, family, 5},
+		{Graphemes, family, 1},
+		{Graphemes, "e\u0301", 1},
+	}
+
+	for _, tc := range testCases {
+		validator := (&StringValidator{}).LengthIn(tc.unit).MaxLength(tc.expected - 1)
+		result := validator.Validate(tc.value)
+		if result.IsValid || result.Errors[0].Params["actual"] != tc.expected {
+			t.Errorf("Expected %q to have %v length %d, got %+v", tc.value, tc.unit, tc.expected, result.Errors)
+		}
+		if result := validator.MaxLength(tc.expected).Validate(tc.value); !result.IsValid {
+			t.Errorf("Expected %q to fit %d %v, got %+v", tc.value, tc.expected, tc.unit, result.Errors)
+		}
+	}
+}
+
+func TestStringValidator_Normalization(t *testing.T) {
+	decomposed := "Jose\u0301"
+	nfc := (&StringValidator{}).NFC().MaxLength(5)
+	result := nfc.Validate(decomposed)
+	if !result.IsValid || result.Value != "José" {
+		t.Errorf("Expected NFC output 'José', got %+v", result)
+	}
+	if result := (&StringValidator{}).MaxLength(5).Validate(decomposed); result.IsValid {
+		t.Error("Expected decomposed string to exceed 5 bytes without normalization")
+	}
+
+	nfkc := (&StringValidator{}).NFKC().Pattern(`^[A-Za-z]+$`)
+	result = nfkc.Validate("\uff26ile")
+	if !result.IsValid || result.Value != "File" {
+		t.Errorf("Expected NFKC output 'File', got %+v", result)
+	}
+}
+
+func TestStringValidator_NonEmptyAndNotBlank(t *testing.T) {
+	nonEmpty := (&StringValidator{}).NonEmpty()
+	if result := nonEmpty.Validate(""); result.IsValid || result.Errors[0].Code != CodeStringEmpty {
+		t.Errorf("Expected empty error, got %+v", result.Errors)
+	}
+	if result := nonEmpty.Validate(" "); !result.IsValid {
+		t.Errorf("Expected whitespace to count as non-empty, got %+v", result.Errors)
+	}
+
+	notBlank := (&StringValidator{}).NonEmpty().NotBlank()
+	for _, value := range []string{"", " \t\n", "\u00a0\u3000", "\u200b\ufeff"} {
+		result := notBlank.Validate(value)
+		if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Code != CodeStringBlank {
+			t.Errorf("Expected one blank error for %q, got %+v", value, result.Errors)
+		}
+	}
+	if result := notBlank.Validate(" x "); !result.IsValid {
+		t.Errorf("Expected ' x ' to be valid, got %+v", result.Errors)
+	}
+}
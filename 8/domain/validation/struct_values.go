@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONField reports how encoding/json maps a struct field. Embedded is true
+// for untagged embedded structs and struct pointers, whose fields are
+// promoted into the parent; otherwise name is the field's key. ok is false
+// for fields encoding/json skips, such as unexported fields and fields
+// tagged "-". FromStruct, Parse and struct validation all use it, so they
+// agree on the keys of a struct
+func JSONField(field reflect.StructField) (name string, embedded, ok bool) {
+	name, _, _ = strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false, false
+	}
+	if field.Anonymous && name == "" {
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			return "", true, true
+		}
+	}
+	if !field.IsExported() {
+		return "", false, false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, false, true
+}
+
+// indirect dereferences pointers so that struct fields and slice items
+// declared as pointers validate like their element values. Nil pointers
+// become nil
+func indirect(value any) any {
+	if value == nil {
+		return nil
+	}
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return nil
+		}
+		reflected = reflected.Elem()
+	}
+	return reflected.Interface()
+}
+
+// toObjectMap converts a map with any key type or a struct into a
+// map[string]any. Struct fields are keyed by their json name
+func toObjectMap(value any) map[string]any {
+	if objValue, ok := value.(map[string]any); ok {
+		return objValue
+	}
+
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Struct {
+		return structToMap(reflected)
+	}
+
+	objValue := make(map[string]any, reflected.Len())
+	for _, key := range reflected.MapKeys() {
+		objValue[fmt.Sprintf("%v", key.Interface())] = reflected.MapIndex(key).Interface()
+	}
+	return objValue
+}
+
+// structToMap collects the exported fields of a struct under their json
+// names, flattening embedded structs and leaving nil pointers out so that
+// they count as missing. Byte slices become base64 strings, as in JSON
+func structToMap(value reflect.Value) map[string]any {
+	fields := make(map[string]any)
+	collectStructFields(value, fields)
+	return fields
+}
+
+func collectStructFields(value reflect.Value, fields map[string]any) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		name, embedded, ok := JSONField(valueType.Field(i))
+		switch {
+		case !ok:
+			continue
+		case embedded:
+			if embeddedValue := reflect.Indirect(value.Field(i)); embeddedValue.IsValid() {
+				collectStructFields(embeddedValue, fields)
+			}
+			continue
+		}
+		fieldValue := indirect(value.Field(i).Interface())
+		if fieldValue == nil {
+			continue
+		}
+		if bytes, ok := fieldValue.([]byte); ok {
+			fieldValue = base64.StdEncoding.EncodeToString(bytes)
+		}
+		fields[name] = fieldValue
+	}
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func (b *BaseValidator) setDefault(value any) {
+	b.defaultValue = value
+	b.hasDefault = true
+}
+
+func (b *BaseValidator) addTransform(transform func(value any) (any, error)) {
+	b.transforms = appendCopy(b.transforms, transform)
+}
+
+// applyDefault substitutes the default value for a missing (nil) value
+func (b *BaseValidator) applyDefault(value any) any {
+	if value == nil && b.hasDefault {
+		return b.defaultValue
+	}
+	return value
+}
+
+// DefaultValue returns the value used in place of a missing one and whether
+// a default was set
+func (b *BaseValidator) DefaultValue() (any, bool) {
+	return b.defaultValue, b.hasDefault
+}
+
+// finish completes validation: with errors the value is rejected, otherwise
+// the transforms registered with Transform run in order and the result
+// carries their output
+func (b *BaseValidator) finish(value any, errors []ValidationError, opts Options) ValidationResult {
+	if len(errors) > 0 {
+		return ValidationResult{IsValid: false, Errors: errors}
+	}
+	for _, transform := range b.transforms {
+		output, err := transform(value)
+		if err != nil {
+			return ValidationResult{
+				IsValid: false,
+				Errors: []ValidationError{
+					b.newError(opts, CodeTransform, map[string]any{"reason": err.Error()}),
+				},
+			}
+		}
+		value = output
+	}
+	return ValidationResult{IsValid: true, Value: value}
+}
+
+// outputOf returns the output of a valid child result. Validators that do
+// not produce an output value (e.g. custom AnyValidator implementations)
+// pass the input through unchanged
+func outputOf(result ValidationResult, input any) any {
+	if result.Value != nil {
+		return result.Value
+	}
+	return input
+}
+
+// rebuildSlice builds the output of an array from its item outputs, keeping
+// the original slice type when every output still fits it and falling back
+// to []any otherwise (e.g. when a transform changed the item type)
+func rebuildSlice(original reflect.Value, items []any) any {
+	elemType := original.Type().Elem()
+	output := reflect.MakeSlice(reflect.SliceOf(elemType), len(items), len(items))
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+		itemValue := reflect.ValueOf(item)
+		if !itemValue.Type().AssignableTo(elemType) {
+			return items
+		}
+		output.Index(i).Set(itemValue)
+	}
+	return output.Interface()
+}
+
+// coerceString converts numbers and booleans to their string form
+func coerceString(value any) any {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+	if _, ok := toFloat64(value); ok {
+		return fmt.Sprint(value)
+	}
+	return value
+}
+
+// coerceNumber turns strings in JSON number syntax such as "42" or " 3.5 "
+// into a json.Number, which keeps large values exact and leaves out "NaN"
+// and "Inf"
+func coerceNumber(value any) any {
+	if s, ok := value.(string); ok {
+		if trimmed := strings.TrimSpace(s); jsonNumberRegex.MatchString(trimmed) {
+			return json.Number(trimmed)
+		}
+	}
+	return value
+}
+
+// coerceBoolean parses strings accepted by strconv.ParseBool, e.g. "true"
+// or "0", into a bool
+func coerceBoolean(value any) any {
+	if s, ok := value.(string); ok {
+		if boolean, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+			return boolean
+		}
+	}
+	return value
+}
@@ -0,0 +1,201 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// UnionValidator accepts a value matching one of several branch validators.
+// In the default mode any matching branch is enough (JSON Schema anyOf);
+// in exclusive mode exactly one branch must match (JSON Schema oneOf)
+type UnionValidator struct {
+	BaseValidator
+	Branches  []AnyValidator
+	exclusive bool
+}
+
+// NewUnionValidator creates a union that accepts values matching any branch
+func NewUnionValidator(branches ...AnyValidator) *UnionValidator {
+	return &UnionValidator{Branches: append([]AnyValidator(nil), branches...)}
+}
+
+// NewOneOfValidator creates a union that accepts values matching exactly one branch
+func NewOneOfValidator(branches ...AnyValidator) *UnionValidator {
+	return &UnionValidator{Branches: append([]AnyValidator(nil), branches...), exclusive: true}
+}
+
+// IsExclusive reports whether exactly one branch must match
+func (u *UnionValidator) IsExclusive() bool {
+	return u.exclusive
+}
+
+func (u *UnionValidator) Validate(value any) ValidationResult {
+	return u.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (u *UnionValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return u.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (u *UnionValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	// Substitute the default for a missing value
+	value = u.applyDefault(value)
+
+	// Handle nil values for optional validation
+	if value == nil && u.isOptional() {
+		return ValidationResult{IsValid: true, Errors: nil}
+	}
+
+	// Run every branch, remembering which ones matched
+	var matched []int
+	var output any
+	failures := make([]ValidationResult, 0, len(u.Branches))
+	for i, branch := range u.Branches {
+		result := validateWithOptions(branch, value, opts)
+		if result.IsValid {
+			matched = append(matched, i)
+			output = outputOf(result, value)
+			if !u.exclusive {
+				return u.refined(output, opts)
+			}
+			continue
+		}
+		failures = append(failures, result)
+	}
+
+	switch {
+	case len(matched) == 1:
+		return u.refined(output, opts)
+	case len(matched) > 1:
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				u.newError(opts, CodeUnionMultipleMatches, map[string]any{"matches": matched}),
+			},
+		}
+	case value == nil:
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				u.newError(opts, CodeUnionRequired, nil),
+			},
+		}
+	}
+
+	return u.mergeFailures(value, failures, opts)
+}
+
+// Refine adds a custom check that receives the value once it matched the
+// union and returns an error, or nil when the value is acceptable
+func (u *UnionValidator) Refine(check func(value any) *ValidationError) *UnionValidator {
+	u = clone(u)
+	u.addRefinement(check)
+	return u
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (u *UnionValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *UnionValidator {
+	u = clone(u)
+	u.addContextRefinement(check)
+	return u
+}
+
+// Transform adds a function that turns the valid value into the output
+func (u *UnionValidator) Transform(transform func(value any) (any, error)) *UnionValidator {
+	u = clone(u)
+	u.addTransform(transform)
+	return u
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (u *UnionValidator) Default(value any) *UnionValidator {
+	u = clone(u)
+	u.setDefault(value)
+	return u
+}
+
+// mergeFailures turns the branch failures into a readable report. Branches
+// that rejected the value's type only contribute their expected type; if a
+// single branch got past the type check its errors are reported as they
+// are, since that is the shape the value was most likely meant to have
+func (u *UnionValidator) mergeFailures(value any, failures []ValidationResult, opts Options) ValidationResult {
+	var expected []string
+	var candidates []ValidationResult
+	for _, failure := range failures {
+		if typeName, ok := typeMismatch(failure); ok {
+			if typeName != "" && !containsString(expected, typeName) {
+				expected = append(expected, typeName)
+			}
+			continue
+		}
+		candidates = append(candidates, failure)
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	if len(candidates) == 0 {
+		return ValidationResult{
+			IsValid: false,
+			Errors: []ValidationError{
+				u.newError(opts, CodeUnionType, map[string]any{
+					"expected": strings.Join(expected, " | "),
+					"actual":   fmt.Sprintf("%T", value),
+				}),
+			},
+		}
+	}
+
+	errors := []ValidationError{
+		u.newError(opts, CodeUnionNoMatch, map[string]any{"branches": len(candidates)}),
+	}
+	for _, candidate := range candidates {
+		errors = append(errors, candidate.Errors...)
+	}
+	return ValidationResult{IsValid: false, Errors: errors}
+}
+
+// typeMismatch reports whether a branch only rejected the type of the value
+// at the root, returning the type name the branch expected
+func typeMismatch(result ValidationResult) (string, bool) {
+	if len(result.Errors) != 1 {
+		return "", false
+	}
+	err := result.Errors[0]
+	if len(err.Path) != 0 || err.Field != "" || !strings.HasSuffix(err.Code, ".type") {
+		return "", false
+	}
+	typeName, _ := err.Params["expected"].(string)
+	return typeName, true
+}
+
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UnionValidator) Optional() Validator[any] {
+	u = clone(u)
+	u.setOptional()
+	return u
+}
+
+// Nullable also accepts null, see NullableValidator
+func (u *UnionValidator) Nullable() *NullableValidator {
+	return nullableOf(u, u.optional)
+}
+
+func (u *UnionValidator) WithMessage(message string) Validator[any] {
+	u = clone(u)
+	u.setMessage(message)
+	return u
+}
@@ -0,0 +1,152 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestUnionValidator_AcceptsAnyBranch(t *testing.T) {
+	validator := NewUnionValidator(&NumberValidator{}, &StringValidator{})
+
+	for _, value := range []any{9.99, 10, "9.99"} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Union validator should accept %v (%T), got %+v", value, value, result.Errors)
+		}
+	}
+}
+
+func TestUnionValidator_TypeMismatchIsMerged(t *testing.T) {
+	validator := NewUnionValidator(&NumberValidator{}, &StringValidator{})
+
+	result := validator.Validate(true)
+	if result.IsValid {
+		t.Fatal("Union validator should reject values matching no branch")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected a single merged error, got %+v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Code != CodeUnionType {
+		t.Errorf("Expected code '%s', got '%s'", CodeUnionType, err.Code)
+	}
+	expected := "Expected number | string, got bool"
+	if err.Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, err.Message)
+	}
+}
+
+func TestUnionValidator_ReportsClosestBranch(t *testing.T) {
+	validator := NewUnionValidator(&NumberValidator{}, (&StringValidator{}).Pattern(`^\d+$`))
+
+	// The value is a string, so only the string branch is relevant
+	result := validator.Validate("abc")
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeStringPattern {
+		t.Errorf("Expected code '%s', got '%s'", CodeStringPattern, result.Errors[0].Code)
+	}
+}
+
+func TestUnionValidator_ReportsEveryCandidateBranch(t *testing.T) {
+	card := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"cardNumber": (&StringValidator{}).MinLength(12),
+	}}
+	pix := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"pixKey": &StringValidator{},
+	}}
+	validator := NewUnionValidator(card, pix)
+
+	result := validator.Validate(map[string]any{"cardNumber": "123"})
+	if result.IsValid {
+		t.Fatal("Union validator should reject objects matching no branch")
+	}
+	if result.Errors[0].Code != CodeUnionNoMatch {
+		t.Errorf("Expected summary code '%s', got '%s'", CodeUnionNoMatch, result.Errors[0].Code)
+	}
+	expected := "Value does not match any of the 2 candidate schemas"
+	if result.Errors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, result.Errors[0].Message)
+	}
+
+	fields := make(map[string]string)
+	for _, err := range result.Errors[1:] {
+		fields[err.Field] = err.Code
+	}
+	if fields["cardNumber"] == "" || fields["pixKey"] != CodeObjectMissingField {
+		t.Errorf("Expected errors from both branches, got %+v", result.Errors)
+	}
+}
+
+func TestUnionValidator_OneOf(t *testing.T) {
+	validator := NewOneOfValidator(
+		(&StringValidator{}).MaxLength(3),
+		(&StringValidator{}).Pattern(`^\d+$`),
+	)
+
+	for _, value := range []any{"abc", "12345"} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("OneOf validator should accept %v, got %+v", value, result.Errors)
+		}
+	}
+
+	result := validator.Validate("123")
+	if result.IsValid {
+		t.Fatal("OneOf validator should reject values matching several branches")
+	}
+	if result.Errors[0].Code != CodeUnionMultipleMatches {
+		t.Errorf("Expected code '%s', got '%s'", CodeUnionMultipleMatches, result.Errors[0].Code)
+	}
+}
+
+func TestUnionValidator_Nil(t *testing.T) {
+	validator := NewUnionValidator(&NumberValidator{}, &StringValidator{})
+
+	result := validator.Validate(nil)
+	if result.IsValid || result.Errors[0].Code != CodeUnionRequired {
+		t.Errorf("Expected required error for nil, got %+v", result.Errors)
+	}
+
+	// An optional branch accepts nil on its own
+	withOptionalBranch := NewUnionValidator(&NumberValidator{}, (&StringValidator{}).Optional())
+	if result := withOptionalBranch.Validate(nil); !result.IsValid {
+		t.Errorf("Expected optional branch to accept nil, got %+v", result.Errors)
+	}
+
+	validator = validator.Optional().(*UnionValidator)
+	if result := validator.Validate(nil); !result.IsValid {
+		t.Error("Optional union should accept nil")
+	}
+}
+
+func TestUnionValidator_InObject(t *testing.T) {
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"price": NewUnionValidator(&NumberValidator{}, &StringValidator{}),
+	}}
+
+	result := validator.Validate(map[string]any{"price": []any{}})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Field != "price" || result.Errors[0].Code != CodeUnionType {
+		t.Errorf("Expected union type error at 'price', got %+v", result.Errors[0])
+	}
+}
+
+func TestUnionValidator_Refine(t *testing.T) {
+	validator := NewUnionValidator(&NumberValidator{}, &StringValidator{}).Refine(func(value any) *ValidationError {
+		if value == "" || value == 0 {
+			return &ValidationError{Message: "Value must not be empty"}
+		}
+		return nil
+	})
+
+	if result := validator.Validate("a"); !result.IsValid {
+		t.Errorf("Expected non-empty value to be valid, got %+v", result.Errors)
+	}
+	for _, value := range []any{"", 0} {
+		result := validator.Validate(value)
+		if result.IsValid || result.Errors[0].Code != CodeCustom {
+			t.Errorf("Expected custom error for %v, got %+v", value, result.Errors)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package validation
+
+import "context"
+
+// UnknownValidator accepts any value, including nil. It stands in where a
+// validator is required but every value is allowed, such as the rest items
+// of an open tuple or the JSON Schema boolean schema true
+type UnknownValidator struct {
+	BaseValidator
+}
+
+func (u *UnknownValidator) Validate(value any) ValidationResult {
+	return u.ValidateWithOptions(value, Options{})
+}
+
+// ValidateContext validates the value with ctx, see BaseValidator
+func (u *UnknownValidator) ValidateContext(ctx context.Context, value any) ValidationResult {
+	return u.ValidateWithOptions(value, Options{Context: ctx})
+}
+
+// ValidateWithOptions validates the value using per-call options such as the
+// message locale
+func (u *UnknownValidator) ValidateWithOptions(value any, opts Options) ValidationResult {
+	return u.refined(u.applyDefault(value), opts)
+}
+
+// Refine adds a custom check that receives the value and returns an error,
+// or nil when the value is acceptable
+func (u *UnknownValidator) Refine(check func(value any) *ValidationError) *UnknownValidator {
+	u = clone(u)
+	u.addRefinement(check)
+	return u
+}
+
+// RefineContext adds a check like Refine that also receives ctx
+func (u *UnknownValidator) RefineContext(check func(ctx context.Context, value any) *ValidationError) *UnknownValidator {
+	u = clone(u)
+	u.addContextRefinement(check)
+	return u
+}
+
+// Transform adds a function that turns the value into the output
+func (u *UnknownValidator) Transform(transform func(value any) (any, error)) *UnknownValidator {
+	u = clone(u)
+	u.addTransform(transform)
+	return u
+}
+
+// Default sets the value for a missing input and makes the validator optional
+func (u *UnknownValidator) Default(value any) *UnknownValidator {
+	u = clone(u)
+	u.setDefault(value)
+	return u
+}
+
+// Optional also accepts a missing object field
+func (u *UnknownValidator) Optional() Validator[any] {
+	u = clone(u)
+	u.setOptional()
+	return u
+}
+
+// Nullable also accepts null, see NullableValidator
+func (u *UnknownValidator) Nullable() *NullableValidator {
+	return nullableOf(u, u.optional)
+}
+
+func (u *UnknownValidator) WithMessage(message string) Validator[any] {
+	u = clone(u)
+	u.setMessage(message)
+	return u
+}
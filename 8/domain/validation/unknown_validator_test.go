@@ -0,0 +1,25 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestUnknownValidator_Validate(t *testing.T) {
+	validator := &UnknownValidator{}
+
+	for _, value := range []any{nil, "x", 1, []any{1}, map[string]any{"a": true}} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got %+v", value, result.Errors)
+		}
+	}
+
+	validator = validator.Refine(func(value any) *ValidationError {
+		if value == nil {
+			return &ValidationError{Message: "Value is required"}
+		}
+		return nil
+	})
+	if result := validator.Validate(nil); result.IsValid || result.Errors[0].Code != CodeCustom {
+		t.Errorf("Expected refinement error, got %+v", result.Errors)
+	}
+}
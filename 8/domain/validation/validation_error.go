@@ -1,13 +1,22 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
-// ValidationError represents a validation error with field path and message
+// ValidationError represents a validation error with field path and message.
+// Path holds the structured location of the failing value and Field its
+// dotted rendering. Code and Params describe the failure in a
+// machine-readable way, e.g. Code "string.min_length" with Params
+// {"min": 2, "actual": 1}
 type ValidationError struct {
 	Field   string
+	Path    Path
 	Message string
+	Code    string
+	Params  map[string]any
 }
 
 func (e ValidationError) Error() string {
@@ -17,8 +26,155 @@ func (e ValidationError) Error() string {
 	return e.Message
 }
 
+// prefixed returns a copy of the error with segment prepended to its path.
+// Errors that only carry a Field (e.g. from custom validators) keep it as a
+// single key segment
+func (e ValidationError) prefixed(segment PathSegment) ValidationError {
+	path := make(Path, 0, len(e.Path)+1)
+	path = append(path, segment)
+	if len(e.Path) == 0 && e.Field != "" {
+		path = append(path, Key(e.Field))
+	} else {
+		path = append(path, e.Path...)
+	}
+	e.Path = path
+	e.Field = path.String()
+	return e
+}
+
 // ValidationResult contains validation results
 type ValidationResult struct {
 	IsValid bool
 	Errors  []ValidationError
+	// Value is the validated value after coercion, defaults and transforms.
+	// It is only set when IsValid is true
+	Value any
+}
+
+// FlattenedErrors holds error messages grouped for forms: FormErrors are
+// about the value as a whole and FieldErrors are keyed by top-level field
+type FlattenedErrors struct {
+	FormErrors  []string
+	FieldErrors map[string][]string
+}
+
+// location returns the path of the error, falling back to its Field as a
+// single key for errors that only carry a Field
+func (e ValidationError) location() Path {
+	if len(e.Path) == 0 && e.Field != "" {
+		return Path{Key(e.Field)}
+	}
+	return e.Path
+}
+
+// Merge returns a result with the errors of r followed by those of others,
+// e.g. to combine the checks of a request body and its query string. It is
+// valid when every result is valid, and then keeps the value of r
+func (r ValidationResult) Merge(others ...ValidationResult) ValidationResult {
+	merged := ValidationResult{IsValid: r.IsValid, Value: r.Value}
+	merged.Errors = append(merged.Errors, r.Errors...)
+	for _, other := range others {
+		merged.IsValid = merged.IsValid && other.IsValid
+		merged.Errors = append(merged.Errors, other.Errors...)
+	}
+	if !merged.IsValid {
+		merged.Value = nil
+	}
+	return merged
+}
+
+// ByPath groups the errors by their Field, keeping their order within each
+// field. Errors about the value as a whole are keyed by ""
+func (r ValidationResult) ByPath() map[string][]ValidationError {
+	grouped := make(map[string][]ValidationError)
+	for _, err := range r.Errors {
+		grouped[err.Field] = append(grouped[err.Field], err)
+	}
+	return grouped
+}
+
+// Flatten returns the error messages grouped by top-level field, e.g. to
+// show them next to the inputs of a form. Errors in nested values are listed
+// under the field that contains them
+func (r ValidationResult) Flatten() FlattenedErrors {
+	flattened := FlattenedErrors{FieldErrors: make(map[string][]string)}
+	for _, err := range r.Errors {
+		path := err.location()
+		if len(path) == 0 {
+			flattened.FormErrors = append(flattened.FormErrors, err.Message)
+			continue
+		}
+		field := path[:1].String()
+		flattened.FieldErrors[field] = append(flattened.FieldErrors[field], err.Message)
+	}
+	return flattened
+}
+
+// Format renders the errors as an indented tree with one line per path
+// segment and the messages below it, in the order of the errors:
+//
+//	address
+//	  postalCode
+//	    - Postal code must be 5 digits
+//	tags
+//	  [0]
+//	    - Expected string value, got int
+//
+// Errors about the value as a whole come first. A valid result formats as ""
+func (r ValidationResult) Format() string {
+	root := &errorTree{}
+	for _, err := range r.Errors {
+		node := root
+		for _, segment := range err.location() {
+			node = node.child(Path{segment}.String())
+		}
+		node.messages = append(node.messages, err.Message)
+	}
+
+	var sb strings.Builder
+	root.write(&sb, 0)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// Err returns an error joining every entry, each of which errors.As can
+// extract as a ValidationError, or nil when there are no errors
+func (r ValidationResult) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	joined := make([]error, len(r.Errors))
+	for i, err := range r.Errors {
+		joined[i] = err
+	}
+	return errors.Join(joined...)
+}
+
+// errorTree is a node of the tree rendered by Format
+type errorTree struct {
+	label    string
+	messages []string
+	children []*errorTree
+}
+
+// child returns the child with the given label, adding it if needed
+func (t *errorTree) child(label string) *errorTree {
+	for _, child := range t.children {
+		if child.label == label {
+			return child
+		}
+	}
+	child := &errorTree{label: label}
+	t.children = append(t.children, child)
+	return child
+}
+
+func (t *errorTree) write(sb *strings.Builder, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, message := range t.messages {
+		sb.WriteString(indent + "- " + message + "\n")
+	}
+	for _, child := range t.children {
+		sb.WriteString(indent + child.label + "\n")
+		child.write(sb, depth+1)
+	}
 }
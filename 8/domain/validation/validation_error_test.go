@@ -1,6 +1,9 @@
 package validation
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -148,3 +151,179 @@ func TestValidationResult_Consistency(t *testing.T) {
 		t.Error("Invalid result should have IsValid=false and at least one error")
 	}
 }
+
+func TestValidationError_CodesAndParams(t *testing.T) {
+	testCases := []struct {
+		name      string
+		validator AnyValidator
+		value     any
+		code      string
+		params    map[string]any
+	}{
+		{"string required", &StringValidator{}, nil, CodeStringRequired, nil},
+		{"string type", &StringValidator{}, 42, CodeStringType, map[string]any{"expected": "string", "actual": "int"}},
+		{"string min length", (&StringValidator{}).MinLength(2), "a", CodeStringMinLength, map[string]any{"min": 2, "actual": 1}},
+		{"string max length", (&StringValidator{}).MaxLength(2), "abc", CodeStringMaxLength, map[string]any{"max": 2, "actual": 3}},
+		{"string pattern", (&StringValidator{}).Pattern(`^\d+$`), "abc", CodeStringPattern, map[string]any{"pattern": `^\d+$`}},
+		{"number required", &NumberValidator{}, nil, CodeNumberRequired, nil},
+		{"number type", &NumberValidator{}, "1", CodeNumberType, map[string]any{"expected": "number", "actual": "string"}},
+		{"number min", (&NumberValidator{}).Min(10), 5, CodeNumberMin, map[string]any{"min": 10.0, "actual": 5.0}},
+		{"number max", (&NumberValidator{}).Max(10), 15, CodeNumberMax, map[string]any{"max": 10.0, "actual": 15.0}},
+		{"boolean required", &BooleanValidator{}, nil, CodeBooleanRequired, nil},
+		{"boolean type", &BooleanValidator{}, "yes", CodeBooleanType, map[string]any{"expected": "boolean", "actual": "string"}},
+		{"date required", &DateValidator{}, nil, CodeDateRequired, nil},
+		{"date type", &DateValidator{}, "2024-01-01", CodeDateType, map[string]any{"expected": "date", "actual": "string"}},
+		{"array required", &ArrayValidator[any]{}, nil, CodeArrayRequired, nil},
+		{"array type", &ArrayValidator[any]{}, 1, CodeArrayType, map[string]any{"expected": "array", "actual": "int"}},
+		{"object required", NewObjectValidator[map[string]any](), nil, CodeObjectRequired, nil},
+		{"object type", NewObjectValidator[map[string]any](), 1, CodeObjectType, map[string]any{"expected": "object", "actual": "int"}},
+		{"object missing field", &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{"name": &StringValidator{}}},
+			map[string]any{}, CodeObjectMissingField, map[string]any{"field": "name"}},
+		{"object unexpected field", &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{"name": &StringValidator{}}},
+			map[string]any{"name": "John", "city": "Paris"}, CodeObjectUnexpectedField, map[string]any{"field": "city"}},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validator.Validate(tc.value)
+		if result.IsValid || len(result.Errors) != 1 {
+			t.Errorf("%s: expected exactly 1 error, got %+v", tc.name, result.Errors)
+			continue
+		}
+		err := result.Errors[0]
+		if err.Code != tc.code {
+			t.Errorf("%s: expected code '%s', got '%s'", tc.name, tc.code, err.Code)
+		}
+		if len(err.Params) != len(tc.params) {
+			t.Errorf("%s: expected params %v, got %v", tc.name, tc.params, err.Params)
+			continue
+		}
+		for key, expected := range tc.params {
+			if err.Params[key] != expected {
+				t.Errorf("%s: expected param %s=%v, got %v", tc.name, key, expected, err.Params[key])
+			}
+		}
+	}
+}
+
+func TestValidationError_CodesPropagateThroughComposites(t *testing.T) {
+	validator := &ArrayValidator[any]{
+		ItemValidator: &ObjectValidator[map[string]any]{
+			Schema: map[string]AnyValidator{"name": (&StringValidator{}).MinLength(2)},
+		},
+	}
+
+	result := validator.Validate([]any{map[string]any{"name": "A"}})
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %+v", result.Errors)
+	}
+	if result.Errors[0].Code != CodeStringMinLength {
+		t.Errorf("Expected nested code '%s', got '%s'", CodeStringMinLength, result.Errors[0].Code)
+	}
+	if result.Errors[0].Params["min"] != 2 {
+		t.Errorf("Expected nested param min=2, got %v", result.Errors[0].Params["min"])
+	}
+}
+
+func nestedResult() ValidationResult {
+	validator := &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+		"name": (&StringValidator{}).MinLength(2),
+		"address": &ObjectValidator[map[string]any]{Schema: map[string]AnyValidator{
+			"city":       &StringValidator{},
+			"postalCode": (&StringValidator{}).Pattern(`^\d{5}$`).WithMessage("Postal code must be 5 digits"),
+		}},
+		"tags": &ArrayValidator[any]{ItemValidator: &StringValidator{}},
+	}}
+	return validator.Order("name", "address", "tags").Validate(map[string]any{
+		"name":    "J",
+		"address": map[string]any{"city": 1, "postalCode": "abc"},
+		"tags":    []any{"go", 2},
+	})
+}
+
+func TestValidationResult_Merge(t *testing.T) {
+	body := ValidationResult{IsValid: true, Value: "body"}
+	query := ValidationResult{IsValid: false, Errors: []ValidationError{{Field: "page", Message: "Invalid page"}}}
+	header := ValidationResult{IsValid: false, Errors: []ValidationError{{Field: "token", Message: "Missing token"}}}
+
+	merged := body.Merge(query, header)
+	if merged.IsValid || merged.Value != nil {
+		t.Errorf("Expected invalid merged result without a value, got %+v", merged)
+	}
+	if len(merged.Errors) != 2 || merged.Errors[0].Field != "page" || merged.Errors[1].Field != "token" {
+		t.Errorf("Expected errors in result order, got %+v", merged.Errors)
+	}
+
+	valid := body.Merge(ValidationResult{IsValid: true, Value: "query"})
+	if !valid.IsValid || valid.Value != "body" || len(valid.Errors) != 0 {
+		t.Errorf("Expected valid merged result keeping the receiver's value, got %+v", valid)
+	}
+}
+
+func TestValidationResult_ByPathAndFlatten(t *testing.T) {
+	result := nestedResult()
+	result.Errors = append(result.Errors, ValidationError{Message: "Object is invalid"})
+
+	byPath := result.ByPath()
+	if len(byPath["address.postalCode"]) != 1 || len(byPath["tags[1]"]) != 1 || len(byPath[""]) != 1 {
+		t.Errorf("Expected errors grouped by path, got %+v", byPath)
+	}
+
+	flattened := result.Flatten()
+	if len(flattened.FormErrors) != 1 || flattened.FormErrors[0] != "Object is invalid" {
+		t.Errorf("Expected one form error, got %v", flattened.FormErrors)
+	}
+	expected := map[string][]string{
+		"name":    {"String must be at least 2 characters long"},
+		"address": {"Expected string value, got int", "Postal code must be 5 digits"},
+		"tags":    {"Expected string value, got int"},
+	}
+	if !reflect.DeepEqual(flattened.FieldErrors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, flattened.FieldErrors)
+	}
+}
+
+func TestValidationResult_Format(t *testing.T) {
+	result := nestedResult()
+	result.Errors = append([]ValidationError{{Message: "Object is invalid"}}, result.Errors...)
+
+	expected := strings.Join([]string{
+		"- Object is invalid",
+		"name",
+		"  - String must be at least 2 characters long",
+		"address",
+		"  city",
+		"    - Expected string value, got int",
+		"  postalCode",
+		"    - Postal code must be 5 digits",
+		"tags",
+		"  [1]",
+		"    - Expected string value, got int",
+	}, "\n")
+	if formatted := result.Format(); formatted != expected {
+		t.Errorf("Expected tree:\n%s\ngot:\n%s", expected, formatted)
+	}
+
+	if formatted := (ValidationResult{IsValid: true}).Format(); formatted != "" {
+		t.Errorf("Expected empty format for a valid result, got %q", formatted)
+	}
+}
+
+func TestValidationResult_Err(t *testing.T) {
+	if err := (ValidationResult{IsValid: true}).Err(); err != nil {
+		t.Errorf("Expected nil error for a valid result, got %v", err)
+	}
+
+	err := nestedResult().Err()
+	if err == nil {
+		t.Fatal("Expected an error for an invalid result")
+	}
+	lines := strings.Split(err.Error(), "\n")
+	if len(lines) != 4 || lines[0] != "name: String must be at least 2 characters long" {
+		t.Errorf("Expected one line per error, got %q", err.Error())
+	}
+
+	var validationError ValidationError
+	if !errors.As(err, &validationError) || validationError.Code != CodeStringMinLength {
+		t.Errorf("Expected errors.As to extract the first ValidationError, got %+v", validationError)
+	}
+}
@@ -1,8 +1,12 @@
 package validation
 
+import "context"
+
 // Validator is a generic interface for validating values of type T
 type Validator[T any] interface {
 	Validate(value any) ValidationResult
+	ValidateWithOptions(value any, opts Options) ValidationResult
+	ValidateContext(ctx context.Context, value any) ValidationResult
 	Optional() Validator[T]
 	WithMessage(message string) Validator[T]
 }
@@ -11,3 +15,25 @@ type Validator[T any] interface {
 type AnyValidator interface {
 	Validate(value any) ValidationResult
 }
+
+// OptionsValidator is implemented by validators that honour per-call options
+type OptionsValidator interface {
+	AnyValidator
+	ValidateWithOptions(value any, opts Options) ValidationResult
+}
+
+// ContextValidator is implemented by validators that honour cancellation
+// and deadlines
+type ContextValidator interface {
+	AnyValidator
+	ValidateContext(ctx context.Context, value any) ValidationResult
+}
+
+// validateWithOptions passes the options down to validators that support
+// them and falls back to plain Validate for any other AnyValidator
+func validateWithOptions(validator AnyValidator, value any, opts Options) ValidationResult {
+	if optionsValidator, ok := validator.(OptionsValidator); ok {
+		return optionsValidator.ValidateWithOptions(value, opts)
+	}
+	return validator.Validate(value)
+}
@@ -0,0 +1,166 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Draft202012 is the meta-schema URI of JSON Schema draft 2020-12
+const Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is the subset of a JSON Schema document understood by the
+// compiler and produced by the exporter. The x-schemes and x-uuidVersions
+// extension keywords carry the options of the "uri" and "uuid" formats.
+// Definitions is the draft-07 spelling of Defs and is only read, as are
+// draft-07 "items" arrays
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 TypeList               `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *AdditionalProperties  `json:"additionalProperties,omitempty"`
+	PropertyNames        *JSONSchema            `json:"propertyNames,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+	PrefixItems          []*JSONSchema          `json:"prefixItems,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Contains             *JSONSchema            `json:"contains,omitempty"`
+	MinContains          *int                   `json:"minContains,omitempty"`
+	MaxContains          *int                   `json:"maxContains,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	URLSchemes           []string               `json:"x-schemes,omitempty"`
+	UUIDVersions         []int                  `json:"x-uuidVersions,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64               `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           *float64               `json:"multipleOf,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Const                any                    `json:"const,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	AllOf                []*JSONSchema          `json:"allOf,omitempty"`
+	If                   *JSONSchema            `json:"if,omitempty"`
+	Then                 *JSONSchema            `json:"then,omitempty"`
+	Else                 *JSONSchema            `json:"else,omitempty"`
+	DependentRequired    map[string][]string    `json:"dependentRequired,omitempty"`
+	DependentSchemas     map[string]*JSONSchema `json:"dependentSchemas,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+	Definitions          map[string]*JSONSchema `json:"definitions,omitempty"`
+
+	// Boolean is set for the boolean schemas true and false, e.g. the
+	// "items": false that closes a tuple; all other fields are then empty
+	Boolean *bool `json:"-"`
+}
+
+// jsonSchemaFields has the fields of JSONSchema without its JSON methods
+type jsonSchemaFields JSONSchema
+
+// UnmarshalJSON also reads draft-07 tuples, which list their items in an
+// "items" array and describe the remaining items with "additionalItems",
+// into PrefixItems and Items
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	var boolean bool
+	if err := json.Unmarshal(data, &boolean); err == nil {
+		*s = JSONSchema{Boolean: &boolean}
+		return nil
+	}
+
+	document := struct {
+		*jsonSchemaFields
+		Items           json.RawMessage `json:"items"`
+		AdditionalItems *JSONSchema     `json:"additionalItems"`
+	}{jsonSchemaFields: (*jsonSchemaFields)(s)}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	items := bytes.TrimSpace(document.Items)
+	switch {
+	case len(items) == 0:
+	case items[0] == '[':
+		if s.PrefixItems != nil {
+			return fmt.Errorf("an items array cannot be combined with prefixItems")
+		}
+		if err := json.Unmarshal(items, &s.PrefixItems); err != nil {
+			return fmt.Errorf("items must be a schema or an array of schemas: %w", err)
+		}
+		s.Items = document.AdditionalItems
+	default:
+		s.Items = &JSONSchema{}
+		if err := json.Unmarshal(items, s.Items); err != nil {
+			return fmt.Errorf("items must be a schema or an array of schemas: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s JSONSchema) MarshalJSON() ([]byte, error) {
+	if s.Boolean != nil {
+		return json.Marshal(*s.Boolean)
+	}
+	return json.Marshal(jsonSchemaFields(s))
+}
+
+// TypeList holds the "type" keyword, which may be a single type name or an
+// array of them
+type TypeList []string
+
+func (t *TypeList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = TypeList{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("type must be a string or an array of strings: %w", err)
+	}
+	*t = multiple
+	return nil
+}
+
+func (t TypeList) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// AdditionalProperties holds the "additionalProperties" keyword, which is
+// either a boolean or a schema for the extra properties
+type AdditionalProperties struct {
+	Allowed bool
+	Schema  *JSONSchema
+}
+
+func (a *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		*a = AdditionalProperties{Allowed: allowed}
+		return nil
+	}
+	var schema JSONSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return fmt.Errorf("additionalProperties must be a boolean or a schema: %w", err)
+	}
+	*a = AdditionalProperties{Allowed: true, Schema: &schema}
+	return nil
+}
+
+func (a AdditionalProperties) MarshalJSON() ([]byte, error) {
+	if a.Schema != nil {
+		return json.Marshal(a.Schema)
+	}
+	return json.Marshal(a.Allowed)
+}
@@ -0,0 +1,637 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"validation-system/domain/validation"
+)
+
+// Compile parses a JSON Schema document and turns it into a validator tree
+func Compile(document []byte) (validation.AnyValidator, error) {
+	var root JSONSchema
+	if err := json.Unmarshal(document, &root); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema document: %w", err)
+	}
+	return CompileSchema(&root)
+}
+
+// CompileFile reads a JSON Schema document from disk and compiles it
+func CompileFile(path string) (validation.AnyValidator, error) {
+	document, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JSON Schema file: %w", err)
+	}
+	return Compile(document)
+}
+
+// CompileSchema turns an already parsed JSON Schema into a validator tree.
+// Properties missing from "required" become optional, and objects accept
+// unknown properties unless "additionalProperties" is false. References to
+// "#/$defs/name" or "#/definitions/name" may be recursive
+func CompileSchema(root *JSONSchema) (validation.AnyValidator, error) {
+	compiler := &compiler{builder: &Schema{}, root: root, registry: validation.NewRegistry(), compiled: make(map[string]string)}
+	validator, err := compiler.compile(root, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := compiler.registry.Check(); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	return validator, nil
+}
+
+// compiler walks a JSON Schema, tracking the location of each node so that
+// errors point at the offending keyword
+type compiler struct {
+	builder *Schema
+	root    *JSONSchema
+	// registry holds the compiled definitions by name, and compiled the
+	// "$ref" each name was first compiled for
+	registry *validation.Registry
+	compiled map[string]string
+}
+
+// compile builds the validator for a node. Optional validators also accept
+// nil, which covers both properties missing from "required" and "null" in
+// the node's type list
+func (c *compiler) compile(node *JSONSchema, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	if node == nil {
+		return nil, c.errorf(location, "schema is empty")
+	}
+
+	if node.Ref != "" {
+		return c.compileRef(node.Ref, location, optional)
+	}
+
+	// The boolean schema true accepts anything; false is only supported
+	// as the "items" of a closed tuple
+	if node.Boolean != nil {
+		if !*node.Boolean {
+			return nil, c.errorf(location, "the false schema is only supported for items")
+		}
+		return c.builder.Unknown(), nil
+	}
+
+	// Like true, schemas with nothing but annotations, such as {} or
+	// {"description": "..."}, accept anything
+	if isAnnotationOnly(node) {
+		if optional {
+			return c.builder.Unknown().Optional(), nil
+		}
+		return c.builder.Unknown(), nil
+	}
+
+	if node.Const != nil {
+		validator := c.builder.Literal(node.Const)
+		if optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	}
+
+	if len(node.Enum) > 0 {
+		// A null member makes the enum nullable
+		values := make([]any, 0, len(node.Enum))
+		for _, value := range node.Enum {
+			if value != nil {
+				values = append(values, value)
+			}
+		}
+		validator := c.builder.Enum(values...)
+		if len(values) < len(node.Enum) {
+			return c.nullable(validator, optional), nil
+		}
+		if optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	}
+
+	if len(node.AnyOf) > 0 || len(node.OneOf) > 0 {
+		return c.compileUnion(node, location, optional)
+	}
+
+	types, nullable, err := c.resolveTypes(node, location)
+	if err != nil {
+		return nil, err
+	}
+	if nullable {
+		validator, err := c.compileTypes(node, types, location, false)
+		if err != nil {
+			return nil, err
+		}
+		return c.nullable(validator, optional), nil
+	}
+	return c.compileTypes(node, types, location, optional)
+}
+
+// isAnnotationOnly reports whether a schema has no keywords other than
+// annotations and definitions
+func isAnnotationOnly(node *JSONSchema) bool {
+	constraints := *node
+	constraints.Schema, constraints.Title, constraints.Description = "", "", ""
+	constraints.Defs, constraints.Definitions = nil, nil
+	return reflect.DeepEqual(constraints, JSONSchema{})
+}
+
+// compileRef builds a lazy reference to a definition, compiling the
+// definition the first time it is referenced. Other keywords next to
+// "$ref" are ignored
+func (c *compiler) compileRef(ref string, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	keyword, name, ok := parseDefinitionRef(ref)
+	if !ok {
+		return nil, c.errorf(at(location, validation.Key("$ref")), "unsupported $ref %q; only local $defs and definitions are supported", ref)
+	}
+
+	switch compiledRef, exists := c.compiled[name]; {
+	case exists && compiledRef != ref:
+		return nil, c.errorf(at(location, validation.Key("$ref")), "definition %q is referenced both as %q and %q", name, compiledRef, ref)
+	case !exists:
+		definitions := c.root.Defs
+		if keyword == "definitions" {
+			definitions = c.root.Definitions
+		}
+		definition, exists := definitions[name]
+		if !exists {
+			return nil, c.errorf(at(location, validation.Key("$ref")), "undefined $ref %q", ref)
+		}
+
+		// Mark the definition before compiling it, so that recursive
+		// references become lazy references
+		c.compiled[name] = ref
+		validator, err := c.compile(definition, definitionLocation(keyword, name), false)
+		if err != nil {
+			return nil, err
+		}
+		c.registry.Define(name, validator)
+	}
+
+	reference := c.registry.Ref(name)
+	if optional {
+		return reference.Optional(), nil
+	}
+	return reference, nil
+}
+
+// parseDefinitionRef splits a "#/$defs/name" or "#/definitions/name"
+// reference, undoing JSON Pointer escapes in the name
+func parseDefinitionRef(ref string) (keyword string, name string, ok bool) {
+	for _, keyword := range []string{"$defs", "definitions"} {
+		prefix := "#/" + keyword + "/"
+		if strings.HasPrefix(ref, prefix) && !strings.Contains(ref[len(prefix):], "/") {
+			return keyword, pointerUnescaper.Replace(ref[len(prefix):]), true
+		}
+	}
+	return "", "", false
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// definitionLocation returns the location of a named definition
+func definitionLocation(keyword string, name string) validation.Path {
+	return validation.Path{validation.Key(keyword), validation.Key(name)}
+}
+
+// nullable wraps a validator so that it also accepts null
+func (c *compiler) nullable(validator validation.AnyValidator, optional bool) validation.AnyValidator {
+	nullable := c.builder.Nullable(validator)
+	if optional {
+		return nullable.Optional()
+	}
+	return nullable
+}
+
+// compileTypes builds the validator for the non-null types of a node
+func (c *compiler) compileTypes(node *JSONSchema, types []string, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	// A list of several types accepts a value of any of them
+	if len(types) > 1 {
+		branches := make([]validation.AnyValidator, 0, len(types))
+		for _, typeName := range types {
+			branch, err := c.compileType(node, typeName, location, false)
+			if err != nil {
+				return nil, err
+			}
+			branches = append(branches, branch)
+		}
+		union := c.builder.Union(branches...)
+		if optional {
+			return union.Optional(), nil
+		}
+		return union, nil
+	}
+	return c.compileType(node, types[0], location, optional)
+}
+
+// compileType builds the validator for a node of a single type
+func (c *compiler) compileType(node *JSONSchema, typeName string, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	switch typeName {
+	case "string":
+		if node.Pattern != "" {
+			if err := validatePattern(node.Pattern); err != nil {
+				return nil, c.errorf(at(location, validation.Key("pattern")), "%v", err)
+			}
+		}
+		return c.compileString(node, optional), nil
+	case "number", "integer":
+		if node.MultipleOf != nil && !(*node.MultipleOf > 0) {
+			return nil, c.errorf(at(location, validation.Key("multipleOf")), "multipleOf must be greater than 0, got %v", *node.MultipleOf)
+		}
+		return c.compileNumber(node, typeName == "integer", optional), nil
+	case "boolean":
+		if optional {
+			return c.builder.Boolean().Optional(), nil
+		}
+		return c.builder.Boolean(), nil
+	case "array":
+		return c.compileArray(node, location, optional)
+	case "object":
+		return c.compileObject(node, location, optional)
+	default:
+		return nil, c.errorf(location, "unsupported type %q", typeName)
+	}
+}
+
+// compileUnion builds the validator for an anyOf or oneOf node
+func (c *compiler) compileUnion(node *JSONSchema, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	if len(node.AnyOf) > 0 && len(node.OneOf) > 0 {
+		return nil, c.errorf(location, "combining anyOf and oneOf is not supported")
+	}
+
+	keyword, schemas := "anyOf", node.AnyOf
+	if len(node.OneOf) > 0 {
+		keyword, schemas = "oneOf", node.OneOf
+	}
+
+	branches := make([]validation.AnyValidator, 0, len(schemas))
+	nullable := false
+	for i, branchSchema := range schemas {
+		// A {"type": "null"} branch makes the whole union nullable
+		if len(branchSchema.Type) == 1 && branchSchema.Type[0] == "null" {
+			nullable = true
+			continue
+		}
+		branch, err := c.compile(branchSchema, at(location, validation.Key(keyword), validation.Index(i)), false)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, branch)
+	}
+
+	union := c.builder.AnyOf(branches...)
+	if keyword == "oneOf" {
+		union = c.builder.OneOf(branches...)
+	}
+	if nullable {
+		return c.nullable(union, optional), nil
+	}
+	if optional {
+		return union.Optional(), nil
+	}
+	return union, nil
+}
+
+// resolveTypes lists the non-null types of a node, inferring the types from
+// the keywords present when "type" is omitted. Keywords of several types,
+// such as {"minLength": 1, "minimum": 0}, infer all of them
+func (c *compiler) resolveTypes(node *JSONSchema, location validation.Path) ([]string, bool, error) {
+	var types []string
+	nullable := false
+	for _, typeName := range node.Type {
+		if typeName == "null" {
+			nullable = true
+			continue
+		}
+		types = append(types, typeName)
+	}
+
+	if len(types) > 0 {
+		return types, nullable, nil
+	}
+
+	if node.Properties != nil || node.PropertyNames != nil || len(node.Required) > 0 ||
+		node.AdditionalProperties != nil || node.MinProperties != nil || node.MaxProperties != nil ||
+		node.If != nil || node.DependentRequired != nil || node.DependentSchemas != nil {
+		types = append(types, "object")
+	}
+	if node.Items != nil || node.PrefixItems != nil || node.MinItems != nil || node.MaxItems != nil ||
+		node.UniqueItems || node.Contains != nil {
+		types = append(types, "array")
+	}
+	if node.MinLength != nil || node.MaxLength != nil || node.Pattern != "" || node.Format != "" ||
+		node.URLSchemes != nil || node.UUIDVersions != nil {
+		types = append(types, "string")
+	}
+	if node.Minimum != nil || node.Maximum != nil || node.ExclusiveMinimum != nil ||
+		node.ExclusiveMaximum != nil || node.MultipleOf != nil {
+		types = append(types, "number")
+	}
+	if len(types) == 0 {
+		return nil, false, c.errorf(location, "missing type")
+	}
+	return types, nullable, nil
+}
+
+func (c *compiler) compileString(node *JSONSchema, optional bool) validation.AnyValidator {
+	// JSON Schema counts lengths in code points
+	validator := c.builder.String().LengthIn(validation.Runes)
+	if node.MinLength != nil {
+		validator = validator.MinLength(*node.MinLength)
+	}
+	if node.MaxLength != nil {
+		validator = validator.MaxLength(*node.MaxLength)
+	}
+	if node.Pattern != "" {
+		validator = validator.Pattern(node.Pattern)
+	}
+	// Unknown formats are annotations only, as in JSON Schema itself
+	switch {
+	case node.Format == validation.FormatURL:
+		validator = validator.URL(node.URLSchemes...)
+	case node.Format == validation.FormatUUID:
+		validator = validator.UUID(node.UUIDVersions...)
+	case validation.IsStringFormat(node.Format):
+		validator = validator.Format(node.Format)
+	}
+	if optional {
+		return validator.Optional()
+	}
+	return validator
+}
+
+func (c *compiler) compileNumber(node *JSONSchema, integer bool, optional bool) validation.AnyValidator {
+	validator := c.builder.Number()
+	if integer {
+		validator = validator.Int()
+	}
+	if node.Minimum != nil {
+		validator = validator.Min(*node.Minimum)
+	}
+	if node.Maximum != nil {
+		validator = validator.Max(*node.Maximum)
+	}
+	if node.ExclusiveMinimum != nil {
+		validator = validator.ExclusiveMin(*node.ExclusiveMinimum)
+	}
+	if node.ExclusiveMaximum != nil {
+		validator = validator.ExclusiveMax(*node.ExclusiveMaximum)
+	}
+	if node.MultipleOf != nil {
+		validator = validator.MultipleOf(*node.MultipleOf)
+	}
+	if optional {
+		return validator.Optional()
+	}
+	return validator
+}
+
+func (c *compiler) compileArray(node *JSONSchema, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	validator := c.builder.Array(nil)
+
+	// prefixItems describes a tuple, which "items": false closes
+	if node.PrefixItems != nil {
+		positions := make([]validation.AnyValidator, len(node.PrefixItems))
+		for i, positionSchema := range node.PrefixItems {
+			position, err := c.compile(positionSchema, at(location, validation.Key("prefixItems"), validation.Index(i)), false)
+			if err != nil {
+				return nil, err
+			}
+			positions[i] = position
+		}
+		validator = validator.Tuple(positions...)
+		if node.Items == nil {
+			validator = validator.Rest(c.builder.Unknown())
+		}
+	}
+	switch {
+	case node.Items == nil:
+	case node.Items.Boolean != nil && !*node.Items.Boolean:
+		// Without prefixItems this only accepts the empty array
+		validator = validator.Tuple(validator.TupleItems()...)
+	default:
+		itemValidator, err := c.compile(node.Items, at(location, validation.Key("items")), false)
+		if err != nil {
+			return nil, err
+		}
+		validator = validator.Rest(itemValidator)
+	}
+
+	if node.MinItems != nil {
+		validator = validator.MinItems(*node.MinItems)
+	}
+	if node.MaxItems != nil {
+		validator = validator.MaxItems(*node.MaxItems)
+	}
+	if node.UniqueItems {
+		validator = validator.Unique()
+	}
+	if node.Contains != nil {
+		contains, err := c.compile(node.Contains, at(location, validation.Key("contains")), false)
+		if err != nil {
+			return nil, err
+		}
+		validator = validator.Contains(contains)
+		if node.MinContains != nil {
+			validator = validator.MinContains(*node.MinContains)
+		}
+		if node.MaxContains != nil {
+			validator = validator.MaxContains(*node.MaxContains)
+		}
+	}
+
+	if optional {
+		return validator.Optional(), nil
+	}
+	return validator, nil
+}
+
+func (c *compiler) compileObject(node *JSONSchema, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	// Objects without properties or rules on named fields that constrain
+	// their keys or their number of entries are records
+	if node.Properties == nil && len(node.Required) == 0 && node.If == nil && len(node.AllOf) == 0 &&
+		node.DependentRequired == nil && node.DependentSchemas == nil &&
+		(node.PropertyNames != nil || node.MinProperties != nil || node.MaxProperties != nil) {
+		return c.compileRecord(node, location, optional)
+	}
+
+	required := make(map[string]bool, len(node.Required))
+	for _, name := range node.Required {
+		required[name] = true
+	}
+
+	fields := make(map[string]validation.AnyValidator, len(node.Properties)+len(node.Required))
+	for name, property := range node.Properties {
+		propertyLocation := at(location, validation.Key("properties"), validation.Key(name))
+		fieldValidator, err := c.compile(property, propertyLocation, !required[name])
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = fieldValidator
+	}
+
+	// Required properties that are not declared only have to be present,
+	// e.g. {"then": {"required": ["cvv"]}}
+	for _, name := range node.Required {
+		if _, declared := fields[name]; !declared {
+			fields[name] = c.builder.Unknown()
+		}
+	}
+
+	validator := c.builder.Object(fields)
+	if additional := node.AdditionalProperties; additional == nil || additional.Allowed {
+		validator = validator.Passthrough()
+		if additional != nil && additional.Schema != nil {
+			catchall, err := c.compile(additional.Schema, at(location, validation.Key("additionalProperties")), false)
+			if err != nil {
+				return nil, err
+			}
+			validator = validator.Catchall(catchall)
+		}
+	} else {
+		// Strict is explicit so that an object without properties rejects
+		// every key
+		validator = validator.Strict()
+	}
+	if node.MinProperties != nil {
+		validator = validator.MinProperties(*node.MinProperties)
+	}
+	if node.MaxProperties != nil {
+		validator = validator.MaxProperties(*node.MaxProperties)
+	}
+	if node.PropertyNames != nil {
+		names, err := c.compilePropertyNames(node.PropertyNames, location)
+		if err != nil {
+			return nil, err
+		}
+		validator = validator.PropertyNames(names)
+	}
+	validator, err := c.compileConditions(validator, node, location)
+	if err != nil {
+		return nil, err
+	}
+	if optional {
+		return validator.Optional(), nil
+	}
+	return validator, nil
+}
+
+// compileConditions returns a copy of an object validator with the
+// if/then/else rules of a node and of its allOf entries, and its
+// dependentRequired and dependentSchemas
+func (c *compiler) compileConditions(validator *validation.ObjectValidator[map[string]any], node *JSONSchema, location validation.Path) (*validation.ObjectValidator[map[string]any], error) {
+	conditions := []*JSONSchema{node}
+	locations := []validation.Path{location}
+	for i, entry := range node.AllOf {
+		entryLocation := at(location, validation.Key("allOf"), validation.Index(i))
+		if entry.If == nil {
+			return nil, c.errorf(entryLocation, "allOf is only supported for if/then/else entries")
+		}
+		conditions = append(conditions, entry)
+		locations = append(locations, entryLocation)
+	}
+
+	for i, condition := range conditions {
+		// then and else without if have no effect, as in JSON Schema
+		if condition.If == nil {
+			continue
+		}
+		branchSchemas := []*JSONSchema{condition.If, condition.Then, condition.Else}
+		branches := make([]validation.AnyValidator, len(branchSchemas))
+		for j, keyword := range []string{"if", "then", "else"} {
+			branchSchema := branchSchemas[j]
+			if branchSchema == nil {
+				continue
+			}
+			branch, err := c.compile(branchSchema, at(locations[i], validation.Key(keyword)), false)
+			if err != nil {
+				return nil, err
+			}
+			branches[j] = branch
+		}
+		validator = validator.If(branches[0]).ThenElse(branches[1], branches[2])
+	}
+
+	if node.DependentRequired != nil {
+		validator = validator.DependentRequired(node.DependentRequired)
+	}
+	if node.DependentSchemas != nil {
+		schemas := make(map[string]validation.AnyValidator, len(node.DependentSchemas))
+		for name, dependentSchema := range node.DependentSchemas {
+			dependent, err := c.compile(dependentSchema, at(location, validation.Key("dependentSchemas"), validation.Key(name)), false)
+			if err != nil {
+				return nil, err
+			}
+			schemas[name] = dependent
+		}
+		validator = validator.DependentSchemas(schemas)
+	}
+	return validator, nil
+}
+
+func (c *compiler) compileRecord(node *JSONSchema, location validation.Path, optional bool) (validation.AnyValidator, error) {
+	validator := c.builder.Record(nil, nil)
+	if node.PropertyNames != nil {
+		keyValidator, err := c.compilePropertyNames(node.PropertyNames, location)
+		if err != nil {
+			return nil, err
+		}
+		validator.KeyValidator = keyValidator
+	}
+	if node.MinProperties != nil {
+		validator = validator.MinProperties(*node.MinProperties)
+	}
+	if node.MaxProperties != nil {
+		validator = validator.MaxProperties(*node.MaxProperties)
+	}
+	if additional := node.AdditionalProperties; additional != nil {
+		switch {
+		case additional.Schema != nil:
+			valueValidator, err := c.compile(additional.Schema, at(location, validation.Key("additionalProperties")), false)
+			if err != nil {
+				return nil, err
+			}
+			validator.ValueValidator = valueValidator
+		case !additional.Allowed:
+			// No entries are allowed at all, whatever maxProperties says
+			validator = validator.MaxProperties(0)
+		}
+	}
+	if optional {
+		return validator.Optional(), nil
+	}
+	return validator, nil
+}
+
+// compilePropertyNames compiles the propertyNames schema of an object.
+// Property names are always strings, so the type may be left out
+func (c *compiler) compilePropertyNames(node *JSONSchema, location validation.Path) (validation.AnyValidator, error) {
+	if node.Boolean == nil && node.Ref == "" && len(node.Type) == 0 && node.Const == nil && len(node.Enum) == 0 &&
+		len(node.AnyOf) == 0 && len(node.OneOf) == 0 {
+		typed := *node
+		typed.Type = TypeList{"string"}
+		node = &typed
+	}
+	return c.compile(node, at(location, validation.Key("propertyNames")), false)
+}
+
+func (c *compiler) errorf(location validation.Path, format string, args ...any) error {
+	return fmt.Errorf("schema %s: %s", pointerOf(location), fmt.Sprintf(format, args...))
+}
+
+// pointerOf renders a location inside a schema document as a JSON Pointer,
+// using "/" for the root
+func pointerOf(location validation.Path) string {
+	if len(location) == 0 {
+		return "/"
+	}
+	return location.JSONPointer()
+}
+
+// at returns a new path with segments appended, leaving location untouched
+func at(location validation.Path, segments ...validation.PathSegment) validation.Path {
+	path := make(validation.Path, 0, len(location)+len(segments))
+	path = append(path, location...)
+	return append(path, segments...)
+}
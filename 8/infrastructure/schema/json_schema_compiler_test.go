@@ -0,0 +1,618 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"validation-system/domain/validation"
+)
+
+const speechAnalysisSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"properties": {
+		"word_count": {"type": "integer", "minimum": 0},
+		"speaking_speed_wpm": {"type": "integer", "minimum": 0, "maximum": 1000},
+		"frequently_mentioned_topics": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"topic": {"type": "string", "minLength": 1, "maxLength": 50},
+					"mentions": {"type": "integer", "minimum": 1}
+				},
+				"required": ["topic", "mentions"],
+				"additionalProperties": false
+			}
+		},
+		"language": {"type": "string", "pattern": "^[a-z]{2}$"},
+		"sentiment": {"enum": ["positive", "neutral", "negative"]},
+		"notes": {"type": ["string", "null"]}
+	},
+	"required": ["word_count", "speaking_speed_wpm", "frequently_mentioned_topics"]
+}`
+
+func TestCompile_ValidDocument(t *testing.T) {
+	validator, err := Compile([]byte(speechAnalysisSchema))
+	if err != nil {
+		t.Fatalf("Compile should accept a valid schema, got %v", err)
+	}
+
+	value := map[string]any{
+		"word_count":         120.0,
+		"speaking_speed_wpm": 140.0,
+		"frequently_mentioned_topics": []any{
+			map[string]any{"topic": "AI", "mentions": 3.0},
+		},
+		"language":  "en",
+		"sentiment": "positive",
+		"notes":     nil,
+		"extra":     "allowed by default",
+	}
+
+	result := validator.Validate(value)
+	if !result.IsValid {
+		t.Errorf("Expected valid value, got errors: %+v", result.Errors)
+	}
+}
+
+func TestCompile_InvalidValue(t *testing.T) {
+	validator, err := Compile([]byte(speechAnalysisSchema))
+	if err != nil {
+		t.Fatalf("Compile should accept a valid schema, got %v", err)
+	}
+
+	value := map[string]any{
+		"word_count":         -1.0,
+		"speaking_speed_wpm": 140.0,
+		"frequently_mentioned_topics": []any{
+			map[string]any{"topic": "", "mentions": 3.0, "extra": true},
+		},
+		"language":  "english",
+		"sentiment": "angry",
+	}
+
+	result := validator.Validate(value)
+	if result.IsValid {
+		t.Fatal("Expected invalid value")
+	}
+
+	fields := make(map[string]string)
+	for _, err := range result.Errors {
+		fields[err.Field] = err.Code
+	}
+	expected := map[string]string{
+		"word_count":                           "number.min",
+		"frequently_mentioned_topics[0].topic": "string.min_length",
+		"frequently_mentioned_topics[0].extra": "object.unexpected_field",
+		"language":                             "string.pattern",
+		"sentiment":                            "enum.invalid",
+	}
+	for field, code := range expected {
+		if fields[field] != code {
+			t.Errorf("Expected error '%s' at '%s', got '%s'", code, field, fields[field])
+		}
+	}
+	if len(result.Errors) != len(expected) {
+		t.Errorf("Expected %d errors, got %d: %+v", len(expected), len(result.Errors), result.Errors)
+	}
+}
+
+func TestCompile_RequiredAndOptionalProperties(t *testing.T) {
+	validator, err := Compile([]byte(speechAnalysisSchema))
+	if err != nil {
+		t.Fatalf("Compile should accept a valid schema, got %v", err)
+	}
+
+	result := validator.Validate(map[string]any{"word_count": 1.0})
+	if result.IsValid {
+		t.Fatal("Expected missing required properties to be rejected")
+	}
+	if len(result.Errors) != 2 {
+		t.Errorf("Expected 2 missing property errors, got %d: %+v", len(result.Errors), result.Errors)
+	}
+	for _, err := range result.Errors {
+		if err.Code != "object.missing_field" {
+			t.Errorf("Expected missing field code, got '%s'", err.Code)
+		}
+	}
+}
+
+func TestCompile_InfersTypeFromKeywords(t *testing.T) {
+	validator, err := Compile([]byte(`{"properties": {"tags": {"items": {"type": "string"}}}}`))
+	if err != nil {
+		t.Fatalf("Compile should infer object and array types, got %v", err)
+	}
+
+	if result := validator.Validate(map[string]any{"tags": []any{"a"}}); !result.IsValid {
+		t.Errorf("Expected valid value, got errors: %+v", result.Errors)
+	}
+	if result := validator.Validate(map[string]any{"tags": []any{1}}); result.IsValid {
+		t.Error("Expected non-string tag to be rejected")
+	}
+}
+
+func TestCompile_InfersTypeFromConstraints(t *testing.T) {
+	testCases := map[string]struct {
+		valid   []any
+		invalid []any
+	}{
+		`{"minLength": 3}`:                 {valid: []any{"abc"}, invalid: []any{"ab"}},
+		`{"pattern": "^a"}`:                {valid: []any{"abc"}, invalid: []any{"bc"}},
+		`{"format": "email"}`:              {valid: []any{"a@b.co"}, invalid: []any{"ab"}},
+		`{"minimum": 1, "multipleOf": 2}`:  {valid: []any{2.0, 4.0}, invalid: []any{0.0, 3.0}},
+		`{"minItems": 1}`:                  {valid: []any{[]any{1}}, invalid: []any{[]any{}}},
+		`{"maxProperties": 1}`:             {valid: []any{map[string]any{"a": 1}}, invalid: []any{map[string]any{"a": 1, "b": 2}}},
+		`{"minLength": 2, "maximum": 5}`:   {valid: []any{"ab", 5.0}, invalid: []any{"a", 6.0, true}},
+		`{"type": "null", "minLength": 1}`: {valid: []any{nil, "a"}, invalid: []any{""}},
+	}
+
+	for document, testCase := range testCases {
+		validator, err := Compile([]byte(document))
+		if err != nil {
+			t.Errorf("Compile(%s) should infer the type, got %v", document, err)
+			continue
+		}
+		for _, value := range testCase.valid {
+			if result := validator.Validate(value); !result.IsValid {
+				t.Errorf("%s: expected %v to be valid, got %+v", document, value, result.Errors)
+			}
+		}
+		for _, value := range testCase.invalid {
+			if result := validator.Validate(value); result.IsValid {
+				t.Errorf("%s: expected %v to be rejected", document, value)
+			}
+		}
+	}
+}
+
+func TestCompile_PermissiveSchemas(t *testing.T) {
+	// {} and annotation-only schemas accept anything, and required
+	// properties without a schema only have to be present
+	validator, err := Compile([]byte(`{
+		"type": "object",
+		"properties": {"payload": {}, "note": {"description": "Free-form note"}},
+		"required": ["payload", "id"]
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+
+	for _, value := range []map[string]any{
+		{"payload": 1, "id": "a"},
+		{"payload": []any{true}, "id": nil, "note": map[string]any{"x": 1}},
+	} {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("%v: expected valid, got %+v", value, result.Errors)
+		}
+	}
+	result := validator.Validate(map[string]any{"payload": 1})
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Field != "id" || result.Errors[0].Code != validation.CodeObjectMissingField {
+		t.Errorf("Expected missing field error at 'id', got %+v", result.Errors)
+	}
+
+	if validator, err := Compile([]byte(`{}`)); err != nil {
+		t.Errorf("Compile should accept the empty schema, got %v", err)
+	} else if result := validator.Validate("anything"); !result.IsValid {
+		t.Errorf("Expected the empty schema to accept anything, got %+v", result.Errors)
+	}
+}
+
+func TestCompile_Draft07Tuples(t *testing.T) {
+	// Draft-07 lists tuple items in an "items" array and closes or extends
+	// the tuple with "additionalItems"
+	validator, err := Compile([]byte(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "array",
+		"items": [{"type": "number"}, {"type": "string"}],
+		"additionalItems": false
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate([]any{1, "a"}); !result.IsValid {
+		t.Errorf("Expected valid tuple, got %+v", result.Errors)
+	}
+	if result := validator.Validate([]any{1, "a", true}); result.IsValid {
+		t.Error("Expected additionalItems: false to reject extra items")
+	}
+	if result := validator.Validate([]any{"a", 1}); result.IsValid {
+		t.Error("Expected items in the wrong order to be rejected")
+	}
+
+	validator, err = Compile([]byte(`{"type": "array", "items": [{"type": "number"}], "additionalItems": {"type": "string"}}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate([]any{1, "a", "b"}); !result.IsValid {
+		t.Errorf("Expected extra string items to be valid, got %+v", result.Errors)
+	}
+	if result := validator.Validate([]any{1, 2}); result.IsValid {
+		t.Error("Expected extra items to match additionalItems")
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	testCases := map[string]string{
+		`not json`: "invalid JSON Schema document",
+		`{"type": "object", "properties": {"a": {"type": "unknown"}}}`:                           `schema /properties/a: unsupported type "unknown"`,
+		`{"type": "array", "items": {"type": "null"}}`:                                           "schema /items: missing type",
+		`{"type": "object", "additionalProperties": {"type": "bogus"}}`:                          `schema /additionalProperties: unsupported type "bogus"`,
+		`{"type": "object", "properties": {"a/b": {"type": 1}}}`:                                 "type must be a string or an array of strings",
+		`{"type": "object", "properties": {"a/b": {"type": "nope"}}}`:                            `schema /properties/a~1b: unsupported type "nope"`,
+		`{"type": "string", "pattern": "[a-"}`:                                                   "schema /pattern: invalid pattern",
+		`{"anyOf": [{"type": "string"}], "oneOf": [{"type": "number"}]}`:                         "combining anyOf and oneOf is not supported",
+		`{"oneOf": [{"type": "string"}, {"type": "what"}]}`:                                      `schema /oneOf/1: unsupported type "what"`,
+		`{"type": "object", "additionalProperties": "yes"}`:                                      "additionalProperties must be a boolean or a schema",
+		`{"type": "object", "properties": {"x": {"type": "array", "items": {"type": "bogus"}}}}`: `schema /properties/x/items: unsupported type "bogus"`,
+		`{"type": "array", "prefixItems": [{"type": "string"}], "items": [{"type": "number"}]}`:  "an items array cannot be combined with prefixItems",
+		`{"type": "array", "items": "number"}`:                                                   "items must be a schema or an array of schemas",
+	}
+
+	for document, expected := range testCases {
+		_, err := Compile([]byte(document))
+		if err == nil {
+			t.Errorf("Compile(%s) should fail", document)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Compile(%s): expected error containing '%s', got '%v'", document, expected, err)
+		}
+	}
+}
+
+func TestCompileFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(path, []byte(speechAnalysisSchema), 0o644); err != nil {
+		t.Fatalf("Failed to write schema file: %v", err)
+	}
+
+	validator, err := CompileFile(path)
+	if err != nil {
+		t.Fatalf("CompileFile should accept a valid schema file, got %v", err)
+	}
+	if validator == nil {
+		t.Fatal("CompileFile should return a validator")
+	}
+
+	if _, err := CompileFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("CompileFile should fail for a missing file")
+	}
+}
+
+func TestCompile_Unions(t *testing.T) {
+	validator, err := Compile([]byte(`{
+		"type": "object",
+		"properties": {
+			"price": {"type": ["number", "string"]},
+			"discount": {"anyOf": [{"type": "number", "maximum": 100}, {"type": "null"}]},
+			"code": {"oneOf": [{"type": "string", "maxLength": 3}, {"type": "string", "pattern": "^[0-9]+$"}]}
+		},
+		"required": ["price", "discount", "code"]
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should accept unions, got %v", err)
+	}
+
+	valid := []map[string]any{
+		{"price": 9.99, "discount": nil, "code": "ABC"},
+		{"price": "9.99", "discount": 10.0, "code": "1234"},
+	}
+	for _, value := range valid {
+		if result := validator.Validate(value); !result.IsValid {
+			t.Errorf("Expected %v to be valid, got errors: %+v", value, result.Errors)
+		}
+	}
+
+	invalid := []map[string]any{
+		{"price": true, "discount": nil, "code": "ABC"},
+		{"price": 1.0, "discount": 200.0, "code": "ABC"},
+		{"price": 1.0, "discount": nil, "code": "123"},
+		{"price": 1.0, "discount": nil, "code": "ABCD"},
+	}
+	for _, value := range invalid {
+		if result := validator.Validate(value); result.IsValid {
+			t.Errorf("Expected %v to be invalid", value)
+		}
+	}
+}
+
+func TestCompile_NumberKeywords(t *testing.T) {
+	validator, err := Compile([]byte(`{"type": "integer", "exclusiveMinimum": 0, "multipleOf": 5}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	for value, valid := range map[any]bool{10: true, 7.5: false, 0: false, 12: false} {
+		if result := validator.Validate(value); result.IsValid != valid {
+			t.Errorf("Expected %v valid=%v, got %+v", value, valid, result.Errors)
+		}
+	}
+
+	if _, err := Compile([]byte(`{"type": "number", "multipleOf": 0}`)); err == nil || !strings.Contains(err.Error(), "/multipleOf") {
+		t.Errorf("Expected multipleOf error, got %v", err)
+	}
+}
+
+func TestCompile_Formats(t *testing.T) {
+	validator, err := Compile([]byte(`{"type": "string", "format": "uri", "x-schemes": ["https"]}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate("https://example.com"); !result.IsValid {
+		t.Errorf("Expected https URL to be valid, got %+v", result.Errors)
+	}
+	if result := validator.Validate("http://example.com"); result.IsValid || result.Errors[0].Code != validation.CodeStringURL {
+		t.Errorf("Expected URL error, got %+v", result.Errors)
+	}
+
+	// Formats the validator does not know are annotations only
+	validator, err = Compile([]byte(`{"type": "string", "format": "credit-card"}`))
+	if err != nil {
+		t.Fatalf("Compile should accept unknown formats, got %v", err)
+	}
+	if result := validator.Validate("anything"); !result.IsValid {
+		t.Errorf("Expected unknown format to be ignored, got %+v", result.Errors)
+	}
+}
+
+func TestCompile_StringLengthInRunes(t *testing.T) {
+	validator, err := Compile([]byte(`{"type": "string", "maxLength": 4}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate("José"); !result.IsValid {
+		t.Errorf("Expected maxLength to count code points, got %+v", result.Errors)
+	}
+}
+
+func TestCompile_ConstAndNullableEnum(t *testing.T) {
+	validator, err := Compile([]byte(`{
+		"type": "object",
+		"properties": {
+			"kind": {"const": "order"},
+			"priority": {"enum": ["low", "high", null]}
+		},
+		"required": ["kind", "priority"]
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+
+	if result := validator.Validate(map[string]any{"kind": "order", "priority": nil}); !result.IsValid {
+		t.Errorf("Expected null priority to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate(map[string]any{"kind": "refund", "priority": "low"})
+	if result.IsValid || result.Errors[0].Code != validation.CodeLiteralInvalid {
+		t.Errorf("Expected const error, got %+v", result.Errors)
+	}
+	if result := validator.Validate(map[string]any{"kind": "order"}); result.IsValid {
+		t.Error("Expected missing nullable field to be rejected")
+	}
+}
+
+func TestCompile_ArrayKeywords(t *testing.T) {
+	validator, err := Compile([]byte(`{
+		"prefixItems": [{"type": "number"}, {"type": "string"}],
+		"minItems": 2,
+		"uniqueItems": true
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+
+	// Without "items", prefixItems leaves extra items unconstrained
+	if result := validator.Validate([]any{1.0, "a", true, nil}); !result.IsValid {
+		t.Errorf("Expected open tuple to accept extra items, got %+v", result.Errors)
+	}
+	result := validator.Validate([]any{1.0, 2.0})
+	if result.IsValid || result.Errors[0].Field != "[1]" || result.Errors[0].Code != validation.CodeStringType {
+		t.Errorf("Expected string error at '[1]', got %+v", result.Errors)
+	}
+	if result := validator.Validate([]any{1.0, "a", 1.0}); result.IsValid || result.Errors[0].Code != validation.CodeArrayUnique {
+		t.Errorf("Expected unique error, got %+v", result.Errors)
+	}
+
+	if _, err := Compile([]byte(`{"type": "object", "properties": {"x": false}}`)); err == nil || !strings.Contains(err.Error(), "/properties/x") {
+		t.Errorf("Expected false schema error, got %v", err)
+	}
+}
+
+func TestCompile_References(t *testing.T) {
+	// draft-07 documents keep their definitions under "definitions"
+	validator, err := Compile([]byte(`{
+		"$ref": "#/definitions/node",
+		"definitions": {
+			"node": {
+				"type": "object",
+				"properties": {"value": {"type": "integer"}, "next": {"$ref": "#/definitions/node"}},
+				"required": ["value"]
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate(map[string]any{"value": 1, "next": map[string]any{"value": 2}}); !result.IsValid {
+		t.Errorf("Expected linked list to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate(map[string]any{"value": 1, "next": map[string]any{"value": 2.5}})
+	if result.IsValid || result.Errors[0].Field != "next.value" {
+		t.Errorf("Expected error at next.value, got %+v", result.Errors)
+	}
+
+	cases := map[string]string{
+		`{"$ref":"https://example.com/schema.json"}`:              `schema /$ref: unsupported $ref "https://example.com/schema.json"; only local $defs and definitions are supported`,
+		`{"$ref":"#/$defs/missing"}`:                              `schema /$ref: undefined $ref "#/$defs/missing"`,
+		`{"$ref":"#/$defs/a","$defs":{"a":{"$ref":"#/$defs/a"}}}`: `schema: definition "a": definition "a" refers to itself without validating anything`,
+		`{"$ref":"#/$defs/a","$defs":{"a":{"type":"bogus"}}}`:     `schema /$defs/a: unsupported type "bogus"`,
+	}
+	for document, expected := range cases {
+		if _, err := Compile([]byte(document)); err == nil || err.Error() != expected {
+			t.Errorf("%s: expected error %q, got %v", document, expected, err)
+		}
+	}
+}
+
+func TestCompile_Conditions(t *testing.T) {
+	validator, err := Compile([]byte(`{
+		"type": "object",
+		"properties": {
+			"delivery": {"enum": ["ship", "pickup"]},
+			"shipping_address": {"type": "string"},
+			"card_number": {"type": "string"},
+			"cvv": {"type": "string"}
+		},
+		"required": ["delivery"],
+		"if": {"properties": {"delivery": {"const": "ship"}}, "required": ["delivery"]},
+		"then": {"required": ["shipping_address"]},
+		"dependentRequired": {"card_number": ["cvv"]}
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+
+	cases := []struct {
+		value    map[string]any
+		expected string
+	}{
+		{map[string]any{"delivery": "pickup"}, ""},
+		{map[string]any{"delivery": "ship", "shipping_address": "Main St"}, ""},
+		{map[string]any{"delivery": "ship"}, "shipping_address"},
+		{map[string]any{"delivery": "pickup", "card_number": "4111"}, "cvv"},
+	}
+	for _, tc := range cases {
+		result := validator.Validate(tc.value)
+		switch {
+		case tc.expected == "" && !result.IsValid:
+			t.Errorf("%v: expected valid, got %+v", tc.value, result.Errors)
+		case tc.expected != "" && (result.IsValid || result.Errors[0].Field != tc.expected):
+			t.Errorf("%v: expected error at %s, got %+v", tc.value, tc.expected, result.Errors)
+		}
+	}
+
+	_, err = Compile([]byte(`{"type":"object","allOf":[{"type":"object"}]}`))
+	if expected := "schema /allOf/0: allOf is only supported for if/then/else entries"; err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestCompile_Records(t *testing.T) {
+	// Without properties, propertyNames and the property counts describe a
+	// record; property names are strings, so their type may be left out
+	validator, err := Compile([]byte(`{
+		"type": "object",
+		"propertyNames": {"pattern": "^[a-z]{2}$"},
+		"additionalProperties": {"type": "string"},
+		"minProperties": 1,
+		"maxProperties": 2
+	}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if _, ok := validator.(*validation.RecordValidator); !ok {
+		t.Fatalf("Expected a record validator, got %T", validator)
+	}
+
+	cases := []struct {
+		value map[string]any
+		code  string
+		field string
+	}{
+		{map[string]any{"en": "Hello", "pt": "Olá"}, "", ""},
+		{map[string]any{}, validation.CodeRecordMinProperties, ""},
+		{map[string]any{"en": "a", "pt": "b", "es": "c"}, validation.CodeRecordMaxProperties, ""},
+		{map[string]any{"english": "Hello"}, validation.CodeRecordInvalidKey, "english"},
+		{map[string]any{"en": 1}, validation.CodeStringType, "en"},
+	}
+	for _, tc := range cases {
+		result := validator.Validate(tc.value)
+		switch {
+		case tc.code == "" && !result.IsValid:
+			t.Errorf("%v: expected valid, got %+v", tc.value, result.Errors)
+		case tc.code != "" && (result.IsValid || result.Errors[0].Code != tc.code || result.Errors[0].Field != tc.field):
+			t.Errorf("%v: expected %s at '%s', got %+v", tc.value, tc.code, tc.field, result.Errors)
+		}
+	}
+
+	// additionalProperties false leaves no room for entries
+	validator, err = Compile([]byte(`{"type": "object", "maxProperties": 5, "additionalProperties": false}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate(map[string]any{"a": 1}); result.IsValid || result.Errors[0].Code != validation.CodeRecordMaxProperties {
+		t.Errorf("Expected closed record to reject entries, got %+v", result.Errors)
+	}
+}
+
+func TestCompile_ClosedObjectWithoutProperties(t *testing.T) {
+	document := `{"type":"object","additionalProperties":false}`
+	validator, err := Compile([]byte(document))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := validator.Validate(map[string]any{}); !result.IsValid {
+		t.Errorf("Expected the empty object to be valid, got %+v", result.Errors)
+	}
+	result := validator.Validate(map[string]any{"a": 1})
+	if result.IsValid || result.Errors[0].Code != validation.CodeObjectUnexpectedField || result.Errors[0].Field != "a" {
+		t.Errorf("Expected every key to be rejected, got %+v", result.Errors)
+	}
+
+	// An open object without properties still accepts any key
+	open, err := Compile([]byte(`{"type":"object"}`))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+	if result := open.Validate(map[string]any{"a": 1}); !result.IsValid {
+		t.Errorf("Expected an open object to accept any key, got %+v", result.Errors)
+	}
+
+	exported, err := Export(validator)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	exported.Schema = ""
+	if encoded, _ := json.Marshal(exported); string(encoded) != document {
+		t.Errorf("Expected %s, got %s", document, encoded)
+	}
+}
+
+func TestCompile_ObjectPropertyConstraints(t *testing.T) {
+	document := `{"type":"object","properties":{"a":{"type":"string"}},"minProperties":2,"maxProperties":3,"propertyNames":{"type":"string","maxLength":3}}`
+	validator, err := Compile([]byte(document))
+	if err != nil {
+		t.Fatalf("Compile should succeed, got %v", err)
+	}
+
+	cases := []struct {
+		value map[string]any
+		code  string
+		field string
+	}{
+		{map[string]any{"a": "x", "b": 1}, "", ""},
+		{map[string]any{"a": "x"}, validation.CodeObjectMinProperties, ""},
+		{map[string]any{"a": "x", "b": 1, "c": 2, "d": 3}, validation.CodeObjectMaxProperties, ""},
+		{map[string]any{"a": "x", "long": 1}, validation.CodeObjectInvalidKey, "long"},
+	}
+	for _, tc := range cases {
+		result := validator.Validate(tc.value)
+		switch {
+		case tc.code == "" && !result.IsValid:
+			t.Errorf("%v: expected valid, got %+v", tc.value, result.Errors)
+		case tc.code != "" && (result.IsValid || result.Errors[0].Code != tc.code || result.Errors[0].Field != tc.field):
+			t.Errorf("%v: expected %s at '%s', got %+v", tc.value, tc.code, tc.field, result.Errors)
+		}
+	}
+
+	exported, err := Export(validator)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	exported.Schema = ""
+	expected := `{"type":"object","properties":{"a":{"type":"string"}},"additionalProperties":true,"propertyNames":{"type":"string","maxLength":3},"minProperties":2,"maxProperties":3}`
+	if again, _ := json.Marshal(exported); string(again) != expected {
+		t.Errorf("Expected %s, got %s", expected, again)
+	}
+}
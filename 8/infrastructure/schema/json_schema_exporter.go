@@ -0,0 +1,503 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+	"validation-system/domain/validation"
+)
+
+// arrayNode is implemented by every ArrayValidator instantiation
+type arrayNode interface {
+	Items() validation.AnyValidator
+	TupleItems() []validation.AnyValidator
+	Constraints() validation.ArrayConstraints
+}
+
+// objectNode is implemented by every ObjectValidator instantiation
+type objectNode interface {
+	Fields() map[string]validation.AnyValidator
+	AllowsUnknown() bool
+	CatchallValidator() validation.AnyValidator
+	Conditions() []validation.Condition
+	Dependencies() validation.ObjectDependencies
+	Constraints() validation.ObjectConstraints
+}
+
+// optionalNode is implemented by every built-in validator
+type optionalNode interface {
+	IsOptional() bool
+}
+
+// Export walks a validator tree and describes it as a draft 2020-12 JSON
+// Schema. Optional object fields are left out of "required", and registry
+// references are collected under "$defs"
+func Export(validator validation.AnyValidator) (*JSONSchema, error) {
+	e := &exporter{
+		defs:      make(map[string]*JSONSchema),
+		sources:   make(map[string]validation.AnyValidator),
+		expanding: make(map[*validation.LazyValidator]bool),
+	}
+	root, err := e.export(validator, nil)
+	if err != nil {
+		return nil, err
+	}
+	root.Schema = Draft202012
+	if len(e.defs) > 0 {
+		root.Defs = e.defs
+	}
+	return root, nil
+}
+
+// ExportJSON exports a validator tree as an indented JSON Schema document
+func ExportJSON(validator validation.AnyValidator) ([]byte, error) {
+	root, err := Export(validator)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// exporter holds the definitions collected while exporting one tree
+type exporter struct {
+	defs map[string]*JSONSchema
+	// sources maps each definition name to the validator it was exported
+	// from, so that two registries reusing a name are reported
+	sources map[string]validation.AnyValidator
+	// expanding holds the anonymous lazy validators being exported inline
+	expanding map[*validation.LazyValidator]bool
+}
+
+func (e *exporter) export(validator validation.AnyValidator, location validation.Path) (*JSONSchema, error) {
+	switch v := validator.(type) {
+	case *validation.StringValidator:
+		return exportString(v.Constraints(), location)
+	case *validation.NumberValidator:
+		return exportNumber(v.Constraints()), nil
+	case *validation.BooleanValidator:
+		return &JSONSchema{Type: TypeList{"boolean"}}, nil
+	case *validation.DateValidator:
+		return exportDate(v.Constraints(), location)
+	case *validation.EnumValidator:
+		if value, ok := v.Literal(); ok {
+			return &JSONSchema{Const: value}, nil
+		}
+		return &JSONSchema{Enum: v.Values}, nil
+	case *validation.NullableValidator:
+		return e.exportNullable(v, location)
+	case *validation.UnknownValidator:
+		return &JSONSchema{Boolean: ptr(true)}, nil
+	case *validation.LazyValidator:
+		return e.exportLazy(v, location)
+	case *validation.UnionValidator:
+		return e.exportUnion(v, location)
+	case *validation.DiscriminatedUnionValidator:
+		return e.exportDiscriminatedUnion(v, location)
+	case *validation.RecordValidator:
+		return e.exportRecord(v, location)
+	case arrayNode:
+		return e.exportArray(v, location)
+	case objectNode:
+		return e.exportObject(v, location)
+	default:
+		return nil, exportErrorf(location, "unsupported validator %T", validator)
+	}
+}
+
+// layoutFormats maps the date layouts that have a JSON Schema format to it
+var layoutFormats = map[string]string{
+	time.RFC3339:     validation.FormatISODateTime,
+	time.RFC3339Nano: validation.FormatISODateTime,
+	time.DateOnly:    validation.FormatISODate,
+}
+
+// exportDate describes a date validator by the JSON values it accepts:
+// strings in a layout with a format, and numbers for Unix epochs. One that
+// only accepts time.Time values is described by the RFC 3339 string
+// encoding/json writes for them. Bounds and calendar rules have no keyword
+// and cannot be exported
+func exportDate(constraints validation.DateConstraints, location validation.Path) (*JSONSchema, error) {
+	switch {
+	case constraints.Min != nil || constraints.Max != nil || constraints.After != nil || constraints.Before != nil:
+		return nil, exportErrorf(location, "date bounds cannot be exported, JSON Schema has no keyword for them")
+	case constraints.Past || constraints.Future:
+		return nil, exportErrorf(location, "Past and Future cannot be exported, JSON Schema has no keyword for them")
+	case len(constraints.Weekdays) > 0 || constraints.BusinessDay:
+		return nil, exportErrorf(location, "Weekdays and BusinessDay cannot be exported, JSON Schema has no keyword for them")
+	}
+	if len(constraints.Layouts) == 0 && constraints.EpochUnit == 0 {
+		return &JSONSchema{Type: TypeList{"string"}, Format: validation.FormatISODateTime}, nil
+	}
+
+	var branches []*JSONSchema
+	exported := make(map[string]bool)
+	for _, layout := range constraints.Layouts {
+		format, ok := layoutFormats[layout]
+		if !ok {
+			return nil, exportErrorf(location, "date layout %q has no JSON Schema format", layout)
+		}
+		if !exported[format] {
+			exported[format] = true
+			branches = append(branches, &JSONSchema{Type: TypeList{"string"}, Format: format})
+		}
+	}
+	// Epochs may have a fraction, e.g. 1700000000.5 seconds
+	if constraints.EpochUnit != 0 {
+		branches = append(branches, &JSONSchema{Type: TypeList{"number"}})
+	}
+	if len(branches) == 1 {
+		return branches[0], nil
+	}
+	return &JSONSchema{AnyOf: branches}, nil
+}
+
+// exportString describes a string validator. JSON Schema counts minLength
+// and maxLength in code points, so lengths in any other unit cannot be
+// exported
+func exportString(constraints validation.StringConstraints, location validation.Path) (*JSONSchema, error) {
+	if (constraints.MinLength != nil || constraints.MaxLength != nil) && constraints.LengthUnit != validation.Runes {
+		return nil, exportErrorf(location, "string lengths in %s cannot be exported, JSON Schema counts runes", constraints.LengthUnit)
+	}
+	minLength := constraints.MinLength
+	if minLength == nil && (constraints.NonEmpty || constraints.NotBlank) {
+		minLength = ptr(1)
+	}
+	return &JSONSchema{
+		Type:         TypeList{"string"},
+		MinLength:    minLength,
+		MaxLength:    constraints.MaxLength,
+		Pattern:      constraints.Pattern,
+		Format:       constraints.Format,
+		URLSchemes:   constraints.URLSchemes,
+		UUIDVersions: constraints.UUIDVersions,
+	}, nil
+}
+
+// exportNumber maps the number constraints onto JSON Schema keywords.
+// Positive and Negative become exclusive bounds at zero and MaxDecimals a
+// multipleOf of the matching power of ten; Finite needs no keyword, as JSON
+// numbers are always finite
+func exportNumber(constraints validation.NumberConstraints) *JSONSchema {
+	node := &JSONSchema{
+		Type:             TypeList{"number"},
+		Minimum:          constraints.Min,
+		Maximum:          constraints.Max,
+		ExclusiveMinimum: constraints.ExclusiveMin,
+		ExclusiveMaximum: constraints.ExclusiveMax,
+		MultipleOf:       constraints.MultipleOf,
+	}
+	if constraints.Integer {
+		node.Type = TypeList{"integer"}
+	}
+	if constraints.Positive && (node.ExclusiveMinimum == nil || *node.ExclusiveMinimum < 0) {
+		node.ExclusiveMinimum = ptr(0.0)
+	}
+	if constraints.Negative && (node.ExclusiveMaximum == nil || *node.ExclusiveMaximum > 0) {
+		node.ExclusiveMaximum = ptr(0.0)
+	}
+	if constraints.MaxDecimals != nil && node.MultipleOf == nil && !constraints.Integer {
+		node.MultipleOf = ptr(math.Pow10(-*constraints.MaxDecimals))
+	}
+	return node
+}
+
+// exportArray describes tuples with prefixItems, closing them with
+// "items": false when they have no rest validator. UniqueBy has no JSON
+// Schema equivalent and exports as uniqueItems, which it implies since equal
+// items have equal keys
+func (e *exporter) exportArray(validator arrayNode, location validation.Path) (*JSONSchema, error) {
+	constraints := validator.Constraints()
+	node := &JSONSchema{
+		Type:        TypeList{"array"},
+		MinItems:    constraints.MinItems,
+		MaxItems:    constraints.MaxItems,
+		UniqueItems: constraints.Unique,
+		MinContains: constraints.MinContains,
+		MaxContains: constraints.MaxContains,
+	}
+
+	if tuple := validator.TupleItems(); tuple != nil {
+		node.PrefixItems = make([]*JSONSchema, len(tuple))
+		for i, position := range tuple {
+			exported, err := e.export(position, at(location, validation.Key("prefixItems"), validation.Index(i)))
+			if err != nil {
+				return nil, err
+			}
+			node.PrefixItems[i] = exported
+		}
+		if validator.Items() == nil {
+			node.Items = &JSONSchema{Boolean: ptr(false)}
+		}
+	}
+	if validator.Items() != nil {
+		items, err := e.export(validator.Items(), at(location, validation.Key("items")))
+		if err != nil {
+			return nil, err
+		}
+		node.Items = items
+	}
+	if constraints.Contains != nil {
+		contains, err := e.export(constraints.Contains, at(location, validation.Key("contains")))
+		if err != nil {
+			return nil, err
+		}
+		node.Contains = contains
+	}
+	return node, nil
+}
+
+func (e *exporter) exportUnion(validator *validation.UnionValidator, location validation.Path) (*JSONSchema, error) {
+	keyword := "anyOf"
+	if validator.IsExclusive() {
+		keyword = "oneOf"
+	}
+
+	branches := make([]*JSONSchema, 0, len(validator.Branches))
+	for i, branch := range validator.Branches {
+		exported, err := e.export(branch, at(location, validation.Key(keyword), validation.Index(i)))
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, exported)
+	}
+
+	if validator.IsExclusive() {
+		return &JSONSchema{OneOf: branches}, nil
+	}
+	return &JSONSchema{AnyOf: branches}, nil
+}
+
+// exportNullable adds "null" to the type list of typed nodes and an
+// {"type": "null"} branch to anything else
+func (e *exporter) exportNullable(validator *validation.NullableValidator, location validation.Path) (*JSONSchema, error) {
+	node, err := e.export(validator.Inner, location)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(node.Type) > 0 && node.Enum == nil && node.Const == nil:
+		node.Type = append(node.Type, "null")
+		return node, nil
+	case node.AnyOf != nil:
+		node.AnyOf = append(node.AnyOf, &JSONSchema{Type: TypeList{"null"}})
+		return node, nil
+	default:
+		return &JSONSchema{AnyOf: []*JSONSchema{node, {Type: TypeList{"null"}}}}, nil
+	}
+}
+
+// exportLazy turns registry references into a "$ref" to their definition,
+// which is exported once. Anonymous lazy validators are exported inline, so
+// they cannot be recursive
+func (e *exporter) exportLazy(validator *validation.LazyValidator, location validation.Path) (*JSONSchema, error) {
+	target, err := validator.Target()
+	if err != nil {
+		return nil, exportErrorf(location, "%v", err)
+	}
+
+	name := validator.Name()
+	if name == "" {
+		if e.expanding[validator] {
+			return nil, exportErrorf(location, "recursive lazy validator cannot be exported inline; define it in a Registry")
+		}
+		e.expanding[validator] = true
+		defer delete(e.expanding, validator)
+		return e.export(target, location)
+	}
+
+	definitionAt := definitionLocation("$defs", name)
+	reference := &JSONSchema{Ref: "#" + definitionAt.JSONPointer()}
+	if source, exists := e.sources[name]; exists {
+		if !sameValidator(source, target) {
+			return nil, exportErrorf(location, "definition %q refers to two different validators", name)
+		}
+		return reference, nil
+	}
+
+	// Register the definition before exporting it, so that recursive
+	// references end at the "$ref"
+	e.sources[name] = target
+	definition, err := e.export(target, definitionAt)
+	if err != nil {
+		return nil, err
+	}
+	e.defs[name] = definition
+	return reference, nil
+}
+
+// sameValidator reports whether two validators are the same instance
+func sameValidator(a, b validation.AnyValidator) bool {
+	first, second := reflect.ValueOf(a), reflect.ValueOf(b)
+	if first.Kind() == reflect.Pointer && second.Kind() == reflect.Pointer {
+		return first.Type() == second.Type() && first.Pointer() == second.Pointer()
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// exportRecord describes key validators with propertyNames and value
+// validators with a schema-valued additionalProperties
+func (e *exporter) exportRecord(validator *validation.RecordValidator, location validation.Path) (*JSONSchema, error) {
+	constraints := validator.Constraints()
+	node := &JSONSchema{
+		Type:          TypeList{"object"},
+		MinProperties: constraints.MinProperties,
+		MaxProperties: constraints.MaxProperties,
+	}
+	if validator.KeyValidator != nil {
+		keys, err := e.export(validator.KeyValidator, at(location, validation.Key("propertyNames")))
+		if err != nil {
+			return nil, err
+		}
+		node.PropertyNames = keys
+	}
+	if validator.ValueValidator != nil {
+		values, err := e.export(validator.ValueValidator, at(location, validation.Key("additionalProperties")))
+		if err != nil {
+			return nil, err
+		}
+		node.AdditionalProperties = &AdditionalProperties{Allowed: true, Schema: values}
+	}
+	return node, nil
+}
+
+// exportDiscriminatedUnion describes each branch as an object whose tag
+// property only accepts the branch's tag value
+func (e *exporter) exportDiscriminatedUnion(validator *validation.DiscriminatedUnionValidator, location validation.Path) (*JSONSchema, error) {
+	tags := validator.Tags()
+	branches := make([]*JSONSchema, 0, len(tags))
+	for i, tag := range tags {
+		branch, err := e.exportObject(validator.Branches[tag], at(location, validation.Key("oneOf"), validation.Index(i)))
+		if err != nil {
+			return nil, err
+		}
+		if branch.Properties == nil {
+			branch.Properties = make(map[string]*JSONSchema)
+		}
+		branch.Properties[validator.Discriminator] = &JSONSchema{Type: TypeList{"string"}, Enum: []any{tag}}
+		if !containsString(branch.Required, validator.Discriminator) {
+			branch.Required = append(branch.Required, validator.Discriminator)
+			sort.Strings(branch.Required)
+		}
+		branches = append(branches, branch)
+	}
+	return &JSONSchema{OneOf: branches}, nil
+}
+
+func (e *exporter) exportObject(validator objectNode, location validation.Path) (*JSONSchema, error) {
+	fields := validator.Fields()
+	node := &JSONSchema{
+		Type:                 TypeList{"object"},
+		Properties:           make(map[string]*JSONSchema, len(fields)),
+		AdditionalProperties: &AdditionalProperties{Allowed: validator.AllowsUnknown()},
+	}
+
+	for name, fieldValidator := range fields {
+		property, err := e.export(fieldValidator, at(location, validation.Key("properties"), validation.Key(name)))
+		if err != nil {
+			return nil, err
+		}
+		node.Properties[name] = property
+		if optional, ok := fieldValidator.(optionalNode); !ok || !optional.IsOptional() {
+			node.Required = append(node.Required, name)
+		}
+	}
+	sort.Strings(node.Required)
+
+	// Strip and Passthrough both accept unknown fields; a catchall
+	// constrains them
+	if catchall := validator.CatchallValidator(); catchall != nil {
+		schema, err := e.export(catchall, at(location, validation.Key("additionalProperties")))
+		if err != nil {
+			return nil, err
+		}
+		node.AdditionalProperties = &AdditionalProperties{Allowed: true, Schema: schema}
+	}
+
+	constraints := validator.Constraints()
+	node.MinProperties = constraints.MinProperties
+	node.MaxProperties = constraints.MaxProperties
+	if constraints.PropertyNames != nil {
+		names, err := e.export(constraints.PropertyNames, at(location, validation.Key("propertyNames")))
+		if err != nil {
+			return nil, err
+		}
+		node.PropertyNames = names
+	}
+
+	if err := e.exportConditions(validator, node, location); err != nil {
+		return nil, err
+	}
+
+	// An object validator without fields accepts any object unless it was
+	// made Strict
+	if len(fields) == 0 {
+		node.Properties = nil
+		if node.AdditionalProperties.Schema == nil && node.AdditionalProperties.Allowed {
+			node.AdditionalProperties = nil
+		}
+	}
+	return node, nil
+}
+
+// exportConditions adds the conditional rules and dependencies of an
+// object. A single condition is written as if/then/else on the object
+// itself; several go into allOf, as a schema has only one "if"
+func (e *exporter) exportConditions(validator objectNode, node *JSONSchema, location validation.Path) error {
+	conditions := validator.Conditions()
+	for i, condition := range conditions {
+		target, targetLocation := node, location
+		if len(conditions) > 1 {
+			target = &JSONSchema{}
+			targetLocation = at(location, validation.Key("allOf"), validation.Index(i))
+			node.AllOf = append(node.AllOf, target)
+		}
+		branches := []validation.AnyValidator{condition.If, condition.Then, condition.Else}
+		exported := make([]*JSONSchema, len(branches))
+		for j, keyword := range []string{"if", "then", "else"} {
+			if branches[j] == nil {
+				continue
+			}
+			branch, err := e.export(branches[j], at(targetLocation, validation.Key(keyword)))
+			if err != nil {
+				return err
+			}
+			exported[j] = branch
+		}
+		target.If, target.Then, target.Else = exported[0], exported[1], exported[2]
+	}
+
+	dependencies := validator.Dependencies()
+	node.DependentRequired = dependencies.Required
+	if dependencies.Schemas != nil {
+		node.DependentSchemas = make(map[string]*JSONSchema, len(dependencies.Schemas))
+		for name, dependent := range dependencies.Schemas {
+			exported, err := e.export(dependent, at(location, validation.Key("dependentSchemas"), validation.Key(name)))
+			if err != nil {
+				return err
+			}
+			node.DependentSchemas[name] = exported
+		}
+	}
+	return nil
+}
+
+func exportErrorf(location validation.Path, format string, args ...any) error {
+	return fmt.Errorf("export %s: %s", pointerOf(location), fmt.Sprintf(format, args...))
+}
+
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ptr returns a pointer to a copy of the value
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,646 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"validation-system/domain/validation"
+)
+
+func TestExport_UserSchema(t *testing.T) {
+	s := &Schema{}
+	userSchema := s.Object(map[string]validation.AnyValidator{
+		"name":     s.String().LengthIn(validation.Runes).MinLength(2).MaxLength(50),
+		"email":    s.String().Pattern(`^[^\s@]+@[^\s@]+\.[^\s@]+$`),
+		"age":      s.Number().Min(0).Max(150).Optional(),
+		"isActive": s.Boolean(),
+		"birthday": s.Date().Optional(),
+		"tags":     s.Array(s.String()),
+		"address": s.Object(map[string]validation.AnyValidator{
+			"postalCode": s.String().Pattern(`^\d{5}$`),
+		}).Optional(),
+		"metadata": s.Object(map[string]validation.AnyValidator{}).Optional(),
+	})
+
+	exported, err := Export(userSchema)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+
+	if exported.Schema != Draft202012 {
+		t.Errorf("Expected $schema '%s', got '%s'", Draft202012, exported.Schema)
+	}
+	if !reflect.DeepEqual(exported.Type, TypeList{"object"}) {
+		t.Errorf("Expected object type, got %v", exported.Type)
+	}
+	expectedRequired := []string{"email", "isActive", "name", "tags"}
+	if !reflect.DeepEqual(exported.Required, expectedRequired) {
+		t.Errorf("Expected required %v, got %v", expectedRequired, exported.Required)
+	}
+	if exported.AdditionalProperties == nil || exported.AdditionalProperties.Allowed {
+		t.Error("Expected additionalProperties false for a strict object")
+	}
+
+	name := exported.Properties["name"]
+	if *name.MinLength != 2 || *name.MaxLength != 50 {
+		t.Errorf("Expected name length bounds 2..50, got %v..%v", *name.MinLength, *name.MaxLength)
+	}
+	age := exported.Properties["age"]
+	if *age.Minimum != 0 || *age.Maximum != 150 {
+		t.Errorf("Expected age bounds 0..150, got %v..%v", *age.Minimum, *age.Maximum)
+	}
+	if exported.Properties["birthday"].Format != "date-time" {
+		t.Error("Expected date to export as date-time string")
+	}
+	if !reflect.DeepEqual(exported.Properties["tags"].Items.Type, TypeList{"string"}) {
+		t.Error("Expected tags items to be strings")
+	}
+	if exported.Properties["address"].Properties["postalCode"].Pattern != `^\d{5}$` {
+		t.Error("Expected nested pattern to be exported")
+	}
+	metadata := exported.Properties["metadata"]
+	if metadata.Properties != nil || metadata.AdditionalProperties != nil {
+		t.Error("Expected empty object schema to accept any object")
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	s := &Schema{}
+	validator := s.Object(map[string]validation.AnyValidator{
+		"word_count": s.Number().Min(0),
+		"topics":     s.Array(s.String()).Optional(),
+	})
+
+	document, err := ExportJSON(validator)
+	if err != nil {
+		t.Fatalf("ExportJSON should succeed, got %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(document, &decoded); err != nil {
+		t.Fatalf("ExportJSON should produce valid JSON, got %v", err)
+	}
+	if decoded["type"] != "object" {
+		t.Errorf("Expected type to be a plain string, got %v", decoded["type"])
+	}
+	if decoded["additionalProperties"] != false {
+		t.Errorf("Expected additionalProperties false, got %v", decoded["additionalProperties"])
+	}
+	if !strings.Contains(string(document), `"required": [
+    "word_count"
+  ]`) {
+		t.Errorf("Expected only word_count to be required, got %s", document)
+	}
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	s := &Schema{}
+	original := s.Object(map[string]validation.AnyValidator{
+		"name": s.String().LengthIn(validation.Runes).MinLength(2),
+		"age":  s.Number().Max(150).Optional(),
+		"role": &validation.EnumValidator{Values: []any{"admin", "user"}},
+		"tags": s.Array(s.String()),
+	})
+
+	document, err := ExportJSON(original)
+	if err != nil {
+		t.Fatalf("ExportJSON should succeed, got %v", err)
+	}
+	compiled, err := Compile(document)
+	if err != nil {
+		t.Fatalf("Compile should accept exported schema, got %v", err)
+	}
+
+	values := []map[string]any{
+		{"name": "John", "role": "admin", "tags": []any{}},
+		{"name": "J", "role": "admin", "tags": []any{}},
+		{"name": "John", "age": 200, "role": "admin", "tags": []any{}},
+		{"name": "John", "role": "guest", "tags": []any{1}},
+		{"name": "John", "role": "user", "tags": []any{}, "extra": 1},
+		{"role": "user"},
+	}
+	for _, value := range values {
+		expected := original.Validate(value)
+		actual := compiled.Validate(value)
+		if expected.IsValid != actual.IsValid || len(expected.Errors) != len(actual.Errors) {
+			t.Errorf("Round trip mismatch for %v: original %+v, compiled %+v", value, expected.Errors, actual.Errors)
+		}
+	}
+}
+
+type customValidator struct{}
+
+func (customValidator) Validate(value any) validation.ValidationResult {
+	return validation.ValidationResult{IsValid: true}
+}
+
+func TestExport_UnsupportedValidator(t *testing.T) {
+	s := &Schema{}
+	validator := s.Object(map[string]validation.AnyValidator{
+		"items": s.Array(customValidator{}),
+	})
+
+	_, err := Export(validator)
+	if err == nil {
+		t.Fatal("Export should fail for custom validators")
+	}
+	if !strings.Contains(err.Error(), "export /properties/items/items: unsupported validator") {
+		t.Errorf("Expected error pointing at the custom validator, got %v", err)
+	}
+}
+
+func TestExport_StringLengthUnits(t *testing.T) {
+	s := &Schema{}
+
+	exported, err := Export(s.String().LengthIn(validation.Runes).MaxLength(8))
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	if *exported.MaxLength != 8 {
+		t.Errorf("Expected maxLength 8, got %v", *exported.MaxLength)
+	}
+
+	// JSON Schema counts code points, so byte and grapheme lengths would
+	// change meaning in the exported document
+	for _, unit := range []validation.LengthUnit{validation.Bytes, validation.Graphemes} {
+		validator := s.Object(map[string]validation.AnyValidator{"name": s.String().LengthIn(unit).MinLength(2)})
+		_, err := Export(validator)
+		if err == nil || !strings.Contains(err.Error(), "export /properties/name: string lengths in "+unit.String()) {
+			t.Errorf("%s: expected export error at the name field, got %v", unit, err)
+		}
+	}
+
+	// Without a length, NonEmpty means the same in every unit
+	if exported, err := Export(s.String().NonEmpty()); err != nil || *exported.MinLength != 1 {
+		t.Errorf("Expected minLength 1 for a non-empty string, got %+v, %v", exported, err)
+	}
+}
+
+func TestExport_Unions(t *testing.T) {
+	s := &Schema{}
+	validator := s.Object(map[string]validation.AnyValidator{
+		"price": s.Union(s.Number(), s.String().Pattern(`^\d+(\.\d+)?$`)),
+		"code":  s.OneOf(s.String().LengthIn(validation.Runes).MaxLength(3), s.Number()).Optional(),
+	})
+
+	exported, err := Export(validator)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+
+	price := exported.Properties["price"]
+	if len(price.AnyOf) != 2 || price.AnyOf[1].Pattern != `^\d+(\.\d+)?$` {
+		t.Errorf("Expected price to export as anyOf, got %+v", price)
+	}
+	code := exported.Properties["code"]
+	if len(code.OneOf) != 2 || *code.OneOf[0].MaxLength != 3 {
+		t.Errorf("Expected code to export as oneOf, got %+v", code)
+	}
+	if !reflect.DeepEqual(exported.Required, []string{"price"}) {
+		t.Errorf("Expected only price to be required, got %v", exported.Required)
+	}
+
+	document, err := ExportJSON(validator)
+	if err != nil {
+		t.Fatalf("ExportJSON should succeed, got %v", err)
+	}
+	compiled, err := Compile(document)
+	if err != nil {
+		t.Fatalf("Compile should accept exported unions, got %v", err)
+	}
+	if result := compiled.Validate(map[string]any{"price": "12.5"}); !result.IsValid {
+		t.Errorf("Expected round-tripped union to accept string price, got %+v", result.Errors)
+	}
+	if result := compiled.Validate(map[string]any{"price": false}); result.IsValid {
+		t.Error("Expected round-tripped union to reject boolean price")
+	}
+}
+
+func TestExport_DiscriminatedUnion(t *testing.T) {
+	s := &Schema{}
+	validator := s.DiscriminatedUnion("type", map[string]*validation.ObjectValidator[map[string]any]{
+		"click": s.Object(map[string]validation.AnyValidator{"x": s.Number(), "y": s.Number()}),
+		"key":   s.Object(map[string]validation.AnyValidator{"key": s.String()}),
+	})
+
+	exported, err := Export(validator)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	if len(exported.OneOf) != 2 {
+		t.Fatalf("Expected 2 oneOf branches, got %+v", exported)
+	}
+	click := exported.OneOf[0]
+	if !reflect.DeepEqual(click.Properties["type"].Enum, []any{"click"}) {
+		t.Errorf("Expected click branch to pin its tag, got %+v", click.Properties["type"])
+	}
+	if !reflect.DeepEqual(click.Required, []string{"type", "x", "y"}) {
+		t.Errorf("Expected tag to be required, got %v", click.Required)
+	}
+
+	document, err := ExportJSON(validator)
+	if err != nil {
+		t.Fatalf("ExportJSON should succeed, got %v", err)
+	}
+	compiled, err := Compile(document)
+	if err != nil {
+		t.Fatalf("Compile should accept the exported union, got %v", err)
+	}
+	if result := compiled.Validate(map[string]any{"type": "key", "key": "a"}); !result.IsValid {
+		t.Errorf("Expected round-tripped union to accept key event, got %+v", result.Errors)
+	}
+	if result := compiled.Validate(map[string]any{"type": "key", "x": 1.0}); result.IsValid {
+		t.Error("Expected round-tripped union to reject mismatched event")
+	}
+}
+
+func TestExport_NumberConstraints(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"integer":   {s.Number().Int().Min(1), `{"type":"integer","minimum":1}`},
+		"exclusive": {s.Number().ExclusiveMin(0).ExclusiveMax(1), `{"type":"number","exclusiveMinimum":0,"exclusiveMaximum":1}`},
+		"positive":  {s.Number().Positive(), `{"type":"number","exclusiveMinimum":0}`},
+		"negative":  {s.Number().Negative().ExclusiveMax(-5), `{"type":"number","exclusiveMaximum":-5}`},
+		"step":      {s.Number().MultipleOf(0.5), `{"type":"number","multipleOf":0.5}`},
+		"decimals":  {s.Number().MaxDecimals(2).Finite(), `{"type":"number","multipleOf":0.01}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		// The compiled schema produces the same document
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+}
+
+func TestExport_StringFormats(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"email":    {s.String().Email(), `{"type":"string","format":"email"}`},
+		"url":      {s.String().URL("https", "wss"), `{"type":"string","format":"uri","x-schemes":["https","wss"]}`},
+		"uuid":     {s.String().UUID(4, 7), `{"type":"string","format":"uuid","x-uuidVersions":[4,7]}`},
+		"cidr":     {s.String().CIDR(), `{"type":"string","format":"cidr"}`},
+		"date":     {s.String().ISODate(), `{"type":"string","format":"date"}`},
+		"datetime": {s.String().ISODateTime(), `{"type":"string","format":"date-time"}`},
+		"semver":   {s.String().LengthIn(validation.Runes).MaxLength(20).Semver(), `{"type":"string","maxLength":20,"format":"semver"}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+}
+
+func TestExport_Dates(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"time.Time": {s.Date(), `{"type":"string","format":"date-time"}`},
+		"date only": {s.Date().Layouts(time.DateOnly), `{"type":"string","format":"date"}`},
+		"rfc3339":   {s.Date().Layouts(time.RFC3339, time.RFC3339Nano), `{"type":"string","format":"date-time"}`},
+		"coerce":    {s.Date().Coerce(), `{"anyOf":[{"type":"string","format":"date-time"},{"type":"string","format":"date"}]}`},
+		"unix":      {s.Date().Unix(), `{"type":"number"}`},
+		"both":      {s.Date().Layouts(time.DateOnly).UnixMilli(), `{"anyOf":[{"type":"string","format":"date"},{"type":"number"}]}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		if document, _ := json.Marshal(exported); string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+	}
+
+	// Rules without a JSON Schema keyword are reported rather than dropped
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	failures := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"min":      {s.Date().Min(christmas), "date bounds cannot be exported"},
+		"before":   {s.Date().Before(christmas), "date bounds cannot be exported"},
+		"future":   {s.Date().Future(), "Past and Future cannot be exported"},
+		"weekdays": {s.Date().Weekdays(time.Monday), "Weekdays and BusinessDay cannot be exported"},
+		"business": {s.Date().BusinessDay(), "Weekdays and BusinessDay cannot be exported"},
+		"layout":   {s.Date().Layouts("02/01/2006"), `date layout "02/01/2006" has no JSON Schema format`},
+	}
+	for name, tc := range failures {
+		validator := s.Object(map[string]validation.AnyValidator{"when": tc.validator})
+		_, err := Export(validator)
+		if err == nil || !strings.Contains(err.Error(), "export /properties/when: "+tc.expected) {
+			t.Errorf("%s: expected error containing '%s', got %v", name, tc.expected, err)
+		}
+	}
+}
+
+func TestExport_EnumLiteralNullable(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"enum":          {s.Enum("low", "high"), `{"enum":["low","high"]}`},
+		"literal":       {s.Literal(2.0), `{"const":2}`},
+		"nullable":      {s.Nullable(s.String().LengthIn(validation.Runes).MinLength(1)), `{"type":["string","null"],"minLength":1}`},
+		"nullableEnum":  {s.Nullable(s.Enum("a")), `{"anyOf":[{"enum":["a"]},{"type":"null"}]}`},
+		"nullableUnion": {s.Nullable(s.AnyOf(s.Number(), s.Boolean())), `{"anyOf":[{"type":"number"},{"type":"boolean"},{"type":"null"}]}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+
+	// Nullable fields stay required
+	exported, _ := Export(s.Object(map[string]validation.AnyValidator{"notes": s.Nullable(s.String())}))
+	if !reflect.DeepEqual(exported.Required, []string{"notes"}) {
+		t.Errorf("Expected nullable field to be required, got %v", exported.Required)
+	}
+}
+
+func TestExport_ArrayConstraints(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"bounds":    {s.Array(s.String()).MinItems(1).MaxItems(5).Unique(), `{"type":"array","items":{"type":"string"},"minItems":1,"maxItems":5,"uniqueItems":true}`},
+		"contains":  {s.Array(nil).Contains(s.Literal("admin")).MaxContains(1), `{"type":"array","contains":{"const":"admin"},"maxContains":1}`},
+		"tuple":     {s.Array(nil).Tuple(s.Number(), s.Number()), `{"type":"array","prefixItems":[{"type":"number"},{"type":"number"}],"items":false}`},
+		"tupleRest": {s.Array(nil).Tuple(s.String()).Rest(s.Boolean()), `{"type":"array","prefixItems":[{"type":"string"}],"items":{"type":"boolean"}}`},
+		"openTuple": {s.Array(nil).Tuple(s.String()).Rest(s.Unknown()), `{"type":"array","prefixItems":[{"type":"string"}],"items":true}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+}
+
+func TestExport_ObjectPoliciesAndComposition(t *testing.T) {
+	s := &Schema{}
+	user := s.Object(map[string]validation.AnyValidator{
+		"name":  s.String(),
+		"email": s.String().Email(),
+	})
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"strict":   {user, `{"type":"object","properties":{"email":{"type":"string","format":"email"},"name":{"type":"string"}},"required":["email","name"],"additionalProperties":false}`},
+		"partial":  {user.Partial(), `{"type":"object","properties":{"email":{"type":"string","format":"email"},"name":{"type":"string"}},"additionalProperties":false}`},
+		"pick":     {user.Pick("name").Passthrough(), `{"type":"object","properties":{"name":{"type":"string"}},"required":["name"],"additionalProperties":true}`},
+		"catchall": {user.Omit("email").Catchall(s.Number()), `{"type":"object","properties":{"name":{"type":"string"}},"required":["name"],"additionalProperties":{"type":"number"}}`},
+		"map":      {s.Object(nil).Catchall(s.Boolean()), `{"type":"object","additionalProperties":{"type":"boolean"}}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+}
+
+func TestExport_Definitions(t *testing.T) {
+	s := &Schema{}
+	registry := validation.NewRegistry()
+	registry.Define("category", s.Object(map[string]validation.AnyValidator{
+		"name":     s.String(),
+		"children": s.Array(registry.Ref("category")).Optional(),
+	}))
+	validator := s.Object(map[string]validation.AnyValidator{
+		"root":   registry.Ref("category"),
+		"parent": s.Nullable(registry.Ref("category")),
+	})
+
+	expected := `{"type":"object","properties":{"parent":{"anyOf":[{"$ref":"#/$defs/category"},{"type":"null"}]},"root":{"$ref":"#/$defs/category"}},"required":["parent","root"],"additionalProperties":false,"$defs":{"category":{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#/$defs/category"}},"name":{"type":"string"}},"required":["name"],"additionalProperties":false}}}`
+	exported, err := Export(validator)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	exported.Schema = ""
+	document, _ := json.Marshal(exported)
+	if string(document) != expected {
+		t.Errorf("Expected %s, got %s", expected, document)
+	}
+
+	compiled, err := Compile(document)
+	if err != nil {
+		t.Fatalf("Compile should accept exported schema, got %v", err)
+	}
+	reexported, _ := Export(compiled)
+	reexported.Schema = ""
+	if again, _ := json.Marshal(reexported); string(again) != expected {
+		t.Errorf("Round trip changed the schema to %s", again)
+	}
+
+	tree := map[string]any{"name": "root", "children": []any{map[string]any{"name": 1}}}
+	result := compiled.Validate(map[string]any{"root": tree, "parent": nil})
+	if result.IsValid || result.Errors[0].Field != "root.children[0].name" {
+		t.Errorf("Expected nested error from the recursive definition, got %+v", result.Errors)
+	}
+}
+
+func TestExport_LazyErrors(t *testing.T) {
+	s := &Schema{}
+
+	var node validation.AnyValidator
+	node = s.Object(map[string]validation.AnyValidator{
+		"next": s.Lazy(func() validation.AnyValidator { return node }).Optional(),
+	})
+	if _, err := Export(node); err == nil || !strings.Contains(err.Error(), "Registry") {
+		t.Errorf("Expected anonymous recursion to be rejected, got %v", err)
+	}
+
+	// Non-recursive lazy validators are exported inline
+	inline := s.Array(s.Lazy(func() validation.AnyValidator { return s.String() }))
+	if exported, err := Export(inline); err != nil || exported.Items.Type[0] != "string" {
+		t.Errorf("Expected inline items schema, got %+v, %v", exported, err)
+	}
+
+	if _, err := Export(validation.NewRegistry().Ref("missing")); err == nil {
+		t.Error("Expected undefined reference to be rejected")
+	}
+
+	first, second := validation.NewRegistry(), validation.NewRegistry()
+	first.Define("id", s.String())
+	second.Define("id", s.Number())
+	if _, err := Export(s.Union(first.Ref("id"), second.Ref("id"))); err == nil {
+		t.Error("Expected conflicting definitions to be rejected")
+	}
+}
+
+func TestExport_Record(t *testing.T) {
+	s := &Schema{}
+	cases := map[string]struct {
+		validator validation.AnyValidator
+		expected  string
+	}{
+		"labels":   {s.Record(s.String().Pattern(`^[a-z]{2}$`), s.String()), `{"type":"object","additionalProperties":{"type":"string"},"propertyNames":{"type":"string","pattern":"^[a-z]{2}$"}}`},
+		"metadata": {s.Record(nil, s.Number()).MinProperties(1).MaxProperties(20), `{"type":"object","additionalProperties":{"type":"number"},"minProperties":1,"maxProperties":20}`},
+		"keys":     {s.Record(s.String().LengthIn(validation.Runes).MaxLength(8), nil), `{"type":"object","propertyNames":{"type":"string","maxLength":8}}`},
+	}
+	for name, tc := range cases {
+		exported, err := Export(tc.validator)
+		if err != nil {
+			t.Fatalf("%s: Export should succeed, got %v", name, err)
+		}
+		exported.Schema = ""
+		document, _ := json.Marshal(exported)
+		if string(document) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, document)
+		}
+
+		compiled, err := Compile(document)
+		if err != nil {
+			t.Fatalf("%s: Compile should accept exported schema, got %v", name, err)
+		}
+		if _, ok := compiled.(*validation.RecordValidator); !ok {
+			t.Errorf("%s: expected a record validator, got %T", name, compiled)
+		}
+		reexported, _ := Export(compiled)
+		reexported.Schema = ""
+		if again, _ := json.Marshal(reexported); string(again) != tc.expected {
+			t.Errorf("%s: round trip changed the schema to %s", name, again)
+		}
+	}
+}
+
+func TestExport_Conditions(t *testing.T) {
+	s := &Schema{}
+	order := s.Object(map[string]validation.AnyValidator{
+		"delivery":         s.Enum("ship", "pickup"),
+		"shipping_address": s.String().Optional(),
+		"card_number":      s.String().Optional(),
+		"cvv":              s.String().Optional(),
+	}).When("delivery", s.Literal("ship")).Then(
+		s.Object(map[string]validation.AnyValidator{"shipping_address": s.String()}).Passthrough(),
+	).DependentRequired(map[string][]string{"card_number": {"cvv"}}).
+		DependentSchemas(map[string]validation.AnyValidator{
+			"cvv": s.Object(map[string]validation.AnyValidator{"card_number": s.String()}).Passthrough(),
+		})
+
+	expected := `{"type":"object","properties":{"card_number":{"type":"string"},"cvv":{"type":"string"},"delivery":{"enum":["ship","pickup"]},"shipping_address":{"type":"string"}},"required":["delivery"],"additionalProperties":false,` +
+		`"if":{"type":"object","properties":{"delivery":{"const":"ship"}},"required":["delivery"],"additionalProperties":true},` +
+		`"then":{"type":"object","properties":{"shipping_address":{"type":"string"}},"required":["shipping_address"],"additionalProperties":true},` +
+		`"dependentRequired":{"card_number":["cvv"]},` +
+		`"dependentSchemas":{"cvv":{"type":"object","properties":{"card_number":{"type":"string"}},"required":["card_number"],"additionalProperties":true}}}`
+	exported, err := Export(order)
+	if err != nil {
+		t.Fatalf("Export should succeed, got %v", err)
+	}
+	exported.Schema = ""
+	document, _ := json.Marshal(exported)
+	if string(document) != expected {
+		t.Errorf("Expected %s, got %s", expected, document)
+	}
+
+	compiled, err := Compile(document)
+	if err != nil {
+		t.Fatalf("Compile should accept exported schema, got %v", err)
+	}
+	reexported, _ := Export(compiled)
+	reexported.Schema = ""
+	if again, _ := json.Marshal(reexported); string(again) != expected {
+		t.Errorf("Round trip changed the schema to %s", again)
+	}
+
+	// Several conditions are written to allOf
+	twice := order.When("delivery", s.Literal("pickup")).Then(
+		s.Object(map[string]validation.AnyValidator{"store": s.String()}).Passthrough(),
+	)
+	exported, err = Export(twice)
+	if err != nil || exported.If != nil || len(exported.AllOf) != 2 || exported.AllOf[1].If == nil {
+		t.Errorf("Expected both conditions in allOf, got %+v, %v", exported, err)
+	}
+}
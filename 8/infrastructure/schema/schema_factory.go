@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"reflect"
 	"validation-system/domain/validation"
 )
 
@@ -27,12 +28,101 @@ func (s *Schema) Date() *validation.DateValidator {
 	return &validation.DateValidator{}
 }
 
+// Enum creates a validator accepting only the given values, e.g. a fixed
+// list of categories. Numbers compare by value regardless of their Go type
+func (s *Schema) Enum(values ...any) *validation.EnumValidator {
+	return validation.NewEnumValidator(values...)
+}
+
+// EnumValue lists the types EnumOf accepts
+type EnumValue interface {
+	~string | ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// EnumOf creates an enum validator from a typed slice such as []string or
+// []Category. Named string types are stored as plain strings so that they
+// match values decoded from JSON
+func EnumOf[T EnumValue](values ...T) *validation.EnumValidator {
+	allowed := make([]any, len(values))
+	for i, value := range values {
+		if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.String {
+			allowed[i] = reflected.String()
+		} else {
+			allowed[i] = value
+		}
+	}
+	return validation.NewEnumValidator(allowed...)
+}
+
+// Literal creates a validator accepting exactly the given value, e.g. a
+// fixed "version": 2 field
+func (s *Schema) Literal(value any) *validation.EnumValidator {
+	return validation.NewLiteralValidator(value)
+}
+
+// Nullable wraps a validator so that it also accepts null, like the
+// Nullable modifier of the builders. Nullable object fields are still
+// required unless made Optional
+func (s *Schema) Nullable(validator validation.AnyValidator) *validation.NullableValidator {
+	return validation.NewNullableValidator(validator)
+}
+
+// Unknown creates a validator accepting any value, e.g. the rest items of
+// an open tuple
+func (s *Schema) Unknown() *validation.UnknownValidator {
+	return &validation.UnknownValidator{}
+}
+
+// Lazy creates a validator that is built on first use, so that a schema can
+// refer to itself. Use a validation.Registry instead when the schema must be
+// exported, as recursive references need a name in "$defs"
+func (s *Schema) Lazy(resolve func() validation.AnyValidator) *validation.LazyValidator {
+	return validation.NewLazyValidator(resolve)
+}
+
 // Object creates a new object validator with the given schema
 func (s *Schema) Object(schema map[string]validation.AnyValidator) *validation.ObjectValidator[map[string]any] {
 	return &validation.ObjectValidator[map[string]any]{Schema: schema}
 }
 
+// ObjectOf creates a new object validator whose Parse methods decode into T
+func ObjectOf[T any](schema map[string]validation.AnyValidator) *validation.ObjectValidator[T] {
+	return &validation.ObjectValidator[T]{Schema: schema}
+}
+
+// Record creates a validator for maps with arbitrary keys, such as metadata
+// or per-locale labels. Keys are checked with key and values with value;
+// either may be nil to accept anything
+func (s *Schema) Record(key validation.AnyValidator, value validation.AnyValidator) *validation.RecordValidator {
+	return validation.NewRecordValidator(key, value)
+}
+
 // Array creates a new array validator with the given item validator
 func (s *Schema) Array(itemValidator validation.AnyValidator) *validation.ArrayValidator[any] {
 	return &validation.ArrayValidator[any]{ItemValidator: itemValidator}
 }
+
+// Union creates a validator accepting values that match any of the given
+// validators, e.g. a price sent either as a number or as a string
+func (s *Schema) Union(validators ...validation.AnyValidator) *validation.UnionValidator {
+	return validation.NewUnionValidator(validators...)
+}
+
+// AnyOf creates a validator accepting values that match at least one of the
+// given validators, like JSON Schema anyOf
+func (s *Schema) AnyOf(validators ...validation.AnyValidator) *validation.UnionValidator {
+	return validation.NewUnionValidator(validators...)
+}
+
+// OneOf creates a validator accepting values that match exactly one of the
+// given validators, like JSON Schema oneOf
+func (s *Schema) OneOf(validators ...validation.AnyValidator) *validation.UnionValidator {
+	return validation.NewOneOfValidator(validators...)
+}
+
+// DiscriminatedUnion creates a validator that reads the discriminator field,
+// e.g. "type", and validates the value against the matching object schema
+func (s *Schema) DiscriminatedUnion(discriminator string, branches map[string]*validation.ObjectValidator[map[string]any]) *validation.DiscriminatedUnionValidator {
+	return validation.NewDiscriminatedUnionValidator(discriminator, branches)
+}
@@ -201,3 +201,87 @@ func TestSchema_MethodChaining(t *testing.T) {
 	// Test that it implements the Validator interface
 	var _ validation.Validator[string] = stringValidator
 }
+
+func TestSchema_ObjectOf(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+	}
+	schema := &Schema{}
+
+	validator := ObjectOf[user](map[string]validation.AnyValidator{
+		"name": schema.String(),
+	})
+
+	parsed, result := validator.Parse(map[string]any{"name": "John"})
+	if !result.IsValid {
+		t.Fatalf("Expected valid value, got errors: %+v", result.Errors)
+	}
+	if parsed.Name != "John" {
+		t.Errorf("Expected parsed name 'John', got '%s'", parsed.Name)
+	}
+}
+
+func TestSchema_Unions(t *testing.T) {
+	schema := &Schema{}
+
+	union := schema.Union(schema.String(), schema.Number())
+	if union.IsExclusive() || len(union.Branches) != 2 {
+		t.Error("Union() should create a non-exclusive union with both branches")
+	}
+
+	anyOf := schema.AnyOf(schema.String())
+	if anyOf.IsExclusive() {
+		t.Error("AnyOf() should create a non-exclusive union")
+	}
+
+	oneOf := schema.OneOf(schema.String(), schema.Number())
+	if !oneOf.IsExclusive() {
+		t.Error("OneOf() should create an exclusive union")
+	}
+}
+
+func TestSchema_DiscriminatedUnion(t *testing.T) {
+	schema := &Schema{}
+
+	validator := schema.DiscriminatedUnion("type", map[string]*validation.ObjectValidator[map[string]any]{
+		"click": schema.Object(map[string]validation.AnyValidator{"x": schema.Number()}),
+		"key":   schema.Object(map[string]validation.AnyValidator{"key": schema.String()}),
+	})
+
+	if validator.Discriminator != "type" || len(validator.Branches) != 2 {
+		t.Error("DiscriminatedUnion() should keep the discriminator and branches")
+	}
+	if result := validator.Validate(map[string]any{"type": "key", "key": "a"}); !result.IsValid {
+		t.Errorf("Expected valid event, got errors: %+v", result.Errors)
+	}
+}
+
+func TestSchema_EnumLiteralNullable(t *testing.T) {
+	s := &Schema{}
+
+	type Category string
+	categories := EnumOf(Category("Electronics"), Category("Books"))
+	if result := categories.Validate("Books"); !result.IsValid {
+		t.Errorf("Expected named string values to match plain strings, got %+v", result.Errors)
+	}
+	result := categories.Validate("Toys")
+	if result.IsValid || result.Errors[0].Message != "Value must be one of [Electronics Books], got Toys" {
+		t.Errorf("Expected error listing the categories, got %+v", result.Errors)
+	}
+
+	if result := s.Enum(1, 2, 3).Validate(2.0); !result.IsValid {
+		t.Errorf("Expected numeric enum to match by value, got %+v", result.Errors)
+	}
+
+	event := s.Object(map[string]validation.AnyValidator{
+		"version":  s.Literal("v2"),
+		"deadline": s.Nullable(s.Date()),
+	})
+	if result := event.Validate(map[string]any{"version": "v2", "deadline": nil}); !result.IsValid {
+		t.Errorf("Expected valid event, got %+v", result.Errors)
+	}
+	result = event.Validate(map[string]any{"version": "v1"})
+	if len(result.Errors) != 2 {
+		t.Errorf("Expected literal and missing field errors, got %+v", result.Errors)
+	}
+}
@@ -0,0 +1,337 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"validation-system/domain/validation"
+)
+
+// FromStruct builds an object validator from the fields of the struct type T.
+// Fields are keyed by their json name and constrained by the validate tag:
+//
+//	Name  string `json:"name" validate:"minLength=2,maxLength=50"`
+//	Email string `json:"email" validate:"pattern=^[^@]+@[^@]+$"`
+//	Age   *int   `json:"age" validate:"optional,min=0,max=150"`
+//
+// Supported rules are optional, minLength, maxLength, pattern, min and max.
+// A pattern may contain commas, so it must be the last rule in the tag.
+// Nested structs, pointers and slices are followed recursively, and the
+// resulting validator accepts both maps and struct values. Maps take the
+// shape encoding/json gives the struct: embedded structs and struct
+// pointers contribute their fields, []byte is a base64 string and time.Time
+// an RFC 3339 string
+func FromStruct[T any]() (*validation.ObjectValidator[T], error) {
+	var zero T
+	structType := reflect.TypeOf(zero)
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("FromStruct requires a struct type, got %v", structType)
+	}
+
+	builder := &structBuilder{schema: &Schema{}, visiting: make(map[reflect.Type]bool)}
+	fields, names, err := builder.structFields(structType, structType.Name())
+	if err != nil {
+		return nil, err
+	}
+	return ObjectOf[T](fields).Order(names...), nil
+}
+
+// MustFromStruct is like FromStruct but panics on invalid tags, for use in
+// package-level schema definitions
+func MustFromStruct[T any]() *validation.ObjectValidator[T] {
+	validator, err := FromStruct[T]()
+	if err != nil {
+		panic(err)
+	}
+	return validator
+}
+
+// structRules holds the constraints parsed from a validate tag
+type structRules struct {
+	optional  bool
+	minLength *int
+	maxLength *int
+	pattern   string
+	min       *float64
+	max       *float64
+}
+
+// structBuilder turns struct types into validator trees, remembering the
+// types currently being built to reject recursive definitions
+type structBuilder struct {
+	schema   *Schema
+	visiting map[reflect.Type]bool
+}
+
+// structFields returns the validators of the fields of a struct type and
+// their json names in declaration order
+func (b *structBuilder) structFields(structType reflect.Type, location string) (map[string]validation.AnyValidator, []string, error) {
+	if b.visiting[structType] {
+		return nil, nil, fmt.Errorf("%s: recursive type %v is not supported", location, structType)
+	}
+	b.visiting[structType] = true
+	defer delete(b.visiting, structType)
+
+	fields := make(map[string]validation.AnyValidator)
+	var names []string
+	add := func(name string, validator validation.AnyValidator) {
+		if _, exists := fields[name]; !exists {
+			names = append(names, name)
+		}
+		fields[name] = validator
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, embedded, ok := validation.JSONField(field)
+		if !ok {
+			continue
+		}
+
+		// Embedded structs and struct pointers without a json name
+		// contribute their own fields
+		if embedded {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+			embeddedFields, embeddedNames, err := b.structFields(embeddedType, location)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, name := range embeddedNames {
+				add(name, embeddedFields[name])
+			}
+			continue
+		}
+
+		fieldLocation := location + "." + field.Name
+		rules, err := parseValidateTag(field.Tag.Get("validate"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", fieldLocation, err)
+		}
+		validator, err := b.fieldValidator(field.Type, rules, fieldLocation)
+		if err != nil {
+			return nil, nil, err
+		}
+		add(name, validator)
+	}
+	return fields, names, nil
+}
+
+func (b *structBuilder) fieldValidator(fieldType reflect.Type, rules structRules, location string) (validation.AnyValidator, error) {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		if err := rules.onlyOptional("time.Time"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		// encoding/json writes times as RFC 3339 strings
+		validator := b.schema.Date().Coerce()
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	}
+
+	// Exact numeric types are validated as numbers, not as strings or structs
+	switch fieldType {
+	case reflect.TypeOf(json.Number("")), reflect.TypeOf(big.Float{}), reflect.TypeOf(big.Rat{}):
+		return numberValidator(b.schema, rules, false, location)
+	case reflect.TypeOf(big.Int{}):
+		return numberValidator(b.schema, rules, true, location)
+	}
+
+	// encoding/json writes byte slices as base64 strings
+	if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.Uint8 {
+		if err := rules.onlyOptional("byte slices"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		validator := b.schema.String().Base64()
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		if rules.min != nil || rules.max != nil {
+			return nil, fmt.Errorf("%s: min and max do not apply to strings", location)
+		}
+		validator := b.schema.String()
+		if rules.minLength != nil {
+			validator = validator.MinLength(*rules.minLength)
+		}
+		if rules.maxLength != nil {
+			validator = validator.MaxLength(*rules.maxLength)
+		}
+		if rules.pattern != "" {
+			validator = validator.Pattern(rules.pattern)
+		}
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return numberValidator(b.schema, rules, true, location)
+	case reflect.Float32, reflect.Float64:
+		return numberValidator(b.schema, rules, false, location)
+	case reflect.Bool:
+		if err := rules.onlyOptional("bool"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		validator := b.schema.Boolean()
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	case reflect.Slice, reflect.Array:
+		if err := rules.onlyOptional("slices"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		itemValidator, err := b.fieldValidator(fieldType.Elem(), structRules{}, location+"[]")
+		if err != nil {
+			return nil, err
+		}
+		validator := b.schema.Array(itemValidator)
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	case reflect.Map:
+		if err := rules.onlyOptional("maps"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		// Map keys are not known up front, so any object is accepted
+		validator := b.schema.Object(map[string]validation.AnyValidator{})
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	case reflect.Struct:
+		if err := rules.onlyOptional("structs"); err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		fields, names, err := b.structFields(fieldType, location)
+		if err != nil {
+			return nil, err
+		}
+		validator := b.schema.Object(fields).Order(names...)
+		if rules.optional {
+			return validator.Optional(), nil
+		}
+		return validator, nil
+	}
+	return nil, fmt.Errorf("%s: unsupported field type %v", location, fieldType)
+}
+
+// numberValidator builds the validator for a numeric field; integer fields
+// only accept whole numbers
+func numberValidator(schema *Schema, rules structRules, integer bool, location string) (validation.AnyValidator, error) {
+	if rules.minLength != nil || rules.maxLength != nil || rules.pattern != "" {
+		return nil, fmt.Errorf("%s: minLength, maxLength and pattern do not apply to numbers", location)
+	}
+	validator := schema.Number()
+	if integer {
+		validator = validator.Int()
+	}
+	if rules.min != nil {
+		validator = validator.Min(*rules.min)
+	}
+	if rules.max != nil {
+		validator = validator.Max(*rules.max)
+	}
+	if rules.optional {
+		return validator.Optional(), nil
+	}
+	return validator, nil
+}
+
+// onlyOptional rejects value constraints on types that do not support them
+func (r structRules) onlyOptional(typeName string) error {
+	if r.minLength != nil || r.maxLength != nil || r.pattern != "" || r.min != nil || r.max != nil {
+		return fmt.Errorf("only the optional rule applies to %s", typeName)
+	}
+	return nil
+}
+
+// parseValidateTag parses a comma separated list of rules such as
+// "optional,minLength=2,pattern=^[a-z]+$"
+func parseValidateTag(tag string) (structRules, error) {
+	var rules structRules
+	for tag != "" {
+		var rule string
+		if strings.HasPrefix(tag, "pattern=") {
+			// The pattern consumes the rest of the tag
+			rule, tag = tag, ""
+		} else {
+			rule, tag, _ = strings.Cut(tag, ",")
+		}
+
+		name, argument, hasArgument := strings.Cut(strings.TrimSpace(rule), "=")
+		var err error
+		switch name {
+		case "":
+			continue
+		case "optional":
+			rules.optional = true
+		case "required":
+			rules.optional = false
+		case "minLength":
+			rules.minLength, err = parseIntRule(name, argument, hasArgument)
+		case "maxLength":
+			rules.maxLength, err = parseIntRule(name, argument, hasArgument)
+		case "min":
+			rules.min, err = parseFloatRule(name, argument, hasArgument)
+		case "max":
+			rules.max, err = parseFloatRule(name, argument, hasArgument)
+		case "pattern":
+			rules.pattern, err = argument, validatePattern(argument)
+		default:
+			err = fmt.Errorf("unknown validate rule %q", name)
+		}
+		if err != nil {
+			return structRules{}, err
+		}
+	}
+	return rules, nil
+}
+
+func parseIntRule(name, argument string, hasArgument bool) (*int, error) {
+	if !hasArgument {
+		return nil, fmt.Errorf("rule %q requires a value", name)
+	}
+	value, err := strconv.Atoi(argument)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q requires an integer, got %q", name, argument)
+	}
+	return &value, nil
+}
+
+func parseFloatRule(name, argument string, hasArgument bool) (*float64, error) {
+	if !hasArgument {
+		return nil, fmt.Errorf("rule %q requires a value", name)
+	}
+	value, err := strconv.ParseFloat(argument, 64)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q requires a number, got %q", name, argument)
+	}
+	return &value, nil
+}
+
+// validatePattern reports invalid regular expressions up front, since
+// StringValidator.Pattern panics on them
+func validatePattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return nil
+}
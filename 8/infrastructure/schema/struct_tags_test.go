@@ -0,0 +1,294 @@
+package schema
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+type tagAddress struct {
+	Street     string `json:"street" validate:"minLength=3"`
+	PostalCode string `json:"postalCode" validate:"pattern=^\\d{5}$"`
+}
+
+type tagAudit struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type tagUser struct {
+	tagAudit
+	ID       string            `json:"id"`
+	Name     string            `json:"name" validate:"minLength=2,maxLength=50"`
+	Email    string            `json:"email" validate:"pattern=^[^\\s@]+@[^\\s@]+\\.[^\\s@]{2,}$"`
+	Age      *int              `json:"age" validate:"optional,min=0,max=150"`
+	IsActive bool              `json:"isActive"`
+	Tags     []string          `json:"tags"`
+	Address  *tagAddress       `json:"address" validate:"optional"`
+	Contacts []tagAddress      `json:"contacts,omitempty" validate:"optional"`
+	Metadata map[string]string `json:"metadata" validate:"optional"`
+	Secret   string            `json:"-"`
+	internal string
+}
+
+func validTagUser() tagUser {
+	age := 30
+	return tagUser{
+		tagAudit: tagAudit{CreatedAt: time.Now()},
+		ID:       "12345",
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Age:      &age,
+		IsActive: true,
+		Tags:     []string{"developer"},
+		Address:  &tagAddress{Street: "Main St", PostalCode: "12345"},
+	}
+}
+
+func TestFromStruct_ValidatesStructValues(t *testing.T) {
+	validator, err := FromStruct[tagUser]()
+	if err != nil {
+		t.Fatalf("FromStruct should accept valid tags, got %v", err)
+	}
+
+	user := validTagUser()
+	if result := validator.Validate(user); !result.IsValid {
+		t.Errorf("Expected valid struct, got errors: %+v", result.Errors)
+	}
+	if result := validator.Validate(&user); !result.IsValid {
+		t.Errorf("Expected valid struct pointer, got errors: %+v", result.Errors)
+	}
+
+	// Nil optional pointers count as missing
+	user.Age = nil
+	user.Address = nil
+	if result := validator.Validate(user); !result.IsValid {
+		t.Errorf("Expected nil optional pointers to be accepted, got errors: %+v", result.Errors)
+	}
+}
+
+func TestFromStruct_ReportsNestedErrors(t *testing.T) {
+	validator := MustFromStruct[tagUser]()
+
+	user := validTagUser()
+	age := 200
+	user.Name = "J"
+	user.Email = "not-an-email"
+	user.Age = &age
+	user.Address.PostalCode = "abc"
+	user.Contacts = []tagAddress{{Street: "Elm St", PostalCode: "12345"}, {Street: "X", PostalCode: "54321"}}
+
+	result := validator.Validate(user)
+	if result.IsValid {
+		t.Fatal("Expected invalid struct")
+	}
+
+	codes := make(map[string]string)
+	for _, err := range result.Errors {
+		codes[err.Field] = err.Code
+	}
+	expected := map[string]string{
+		"name":               "string.min_length",
+		"email":              "string.pattern",
+		"age":                "number.max",
+		"address.postalCode": "string.pattern",
+		"contacts[1].street": "string.min_length",
+	}
+	for field, code := range expected {
+		if codes[field] != code {
+			t.Errorf("Expected '%s' at '%s', got '%s'", code, field, codes[field])
+		}
+	}
+	if len(result.Errors) != len(expected) {
+		t.Errorf("Expected %d errors, got %d: %+v", len(expected), len(result.Errors), result.Errors)
+	}
+
+	// Errors follow the order in which the struct declares its fields
+	var fields []string
+	for _, err := range result.Errors {
+		fields = append(fields, err.Field)
+	}
+	order := []string{"name", "email", "age", "address.postalCode", "contacts[1].street"}
+	if strings.Join(fields, ",") != strings.Join(order, ",") {
+		t.Errorf("Expected errors in declaration order %v, got %v", order, fields)
+	}
+}
+
+func TestFromStruct_ValidatesAndParsesMaps(t *testing.T) {
+	validator := MustFromStruct[tagUser]()
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	user, result := validator.Parse(map[string]any{
+		"createdAt": createdAt,
+		"id":        "1",
+		"name":      "Ana",
+		"email":     "ana@example.com",
+		"isActive":  false,
+		"tags":      []any{},
+		"age":       41,
+	})
+	if !result.IsValid {
+		t.Fatalf("Expected valid map, got errors: %+v", result.Errors)
+	}
+	if user.Name != "Ana" || user.Age == nil || *user.Age != 41 || !user.CreatedAt.Equal(createdAt) {
+		t.Errorf("Unexpected parsed user %+v", user)
+	}
+
+	result = validator.Validate(map[string]any{"id": "1", "Secret": "x"})
+	if result.IsValid {
+		t.Error("Expected missing required fields and unknown keys to be rejected")
+	}
+}
+
+// TagBase is exported so that Parse can allocate it when embedded as a
+// pointer, as with encoding/json
+type TagBase struct {
+	ID      string    `json:"id" validate:"minLength=1"`
+	Created time.Time `json:"created"`
+}
+
+type tagDocument struct {
+	*TagBase
+	Title   string `json:"title"`
+	Content []byte `json:"content"`
+	Digest  []byte `json:"digest,omitempty" validate:"optional"`
+}
+
+func TestFromStruct_AcceptsItsJSONEncoding(t *testing.T) {
+	validator := MustFromStruct[tagDocument]()
+	document := tagDocument{
+		TagBase: &TagBase{ID: "doc-1", Created: time.Date(2024, 3, 1, 12, 30, 0, 5, time.UTC)},
+		Title:   "Notes",
+		Content: []byte("hi"),
+	}
+
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	// The struct and its JSON encoding validate alike
+	if result := validator.Validate(document); !result.IsValid {
+		t.Errorf("Expected valid struct, got %+v", result.Errors)
+	}
+	if result := validator.Validate(decoded); !result.IsValid {
+		t.Errorf("Expected the JSON encoding of %s to be valid, got %+v", encoded, result.Errors)
+	}
+
+	parsed, result := validator.ParseJSON(encoded)
+	if !result.IsValid {
+		t.Fatalf("Expected ParseJSON to succeed, got %+v", result.Errors)
+	}
+	if parsed.TagBase == nil || parsed.ID != "doc-1" || !parsed.Created.Equal(document.Created) || string(parsed.Content) != "hi" {
+		t.Errorf("Expected the document back, got %+v", parsed)
+	}
+
+	decoded["content"] = "not base64!"
+	if result := validator.Validate(decoded); result.IsValid || result.Errors[0].Field != "content" {
+		t.Errorf("Expected invalid base64 to be rejected, got %+v", result.Errors)
+	}
+
+	// Without the embedded pointer its fields are missing, as in JSON
+	if result := validator.Validate(tagDocument{Title: "Notes", Content: []byte{}}); result.IsValid || result.Errors[0].Field != "id" {
+		t.Errorf("Expected missing embedded fields, got %+v", result.Errors)
+	}
+}
+
+type tagNode struct {
+	Children []tagNode `json:"children"`
+}
+
+type tagInterface struct {
+	Value any `json:"value"`
+}
+
+type tagBadRule struct {
+	Name string `json:"name" validate:"min=2"`
+}
+
+type tagUnknownRule struct {
+	Name string `json:"name" validate:"email"`
+}
+
+type tagBadNumber struct {
+	Age int `json:"age" validate:"minLength=2"`
+}
+
+type tagBadPattern struct {
+	Name string `json:"name" validate:"pattern=[a-"`
+}
+
+type tagBadValue struct {
+	Name string `json:"name" validate:"minLength=two"`
+}
+
+func TestFromStruct_Errors(t *testing.T) {
+	testCases := []struct {
+		build    func() error
+		expected string
+	}{
+		{func() error { _, err := FromStruct[tagNode](); return err }, "recursive type"},
+		{func() error { _, err := FromStruct[tagInterface](); return err }, "tagInterface.Value: unsupported field type"},
+		{func() error { _, err := FromStruct[tagBadRule](); return err }, "min and max do not apply to strings"},
+		{func() error { _, err := FromStruct[tagUnknownRule](); return err }, `unknown validate rule "email"`},
+		{func() error { _, err := FromStruct[tagBadNumber](); return err }, "do not apply to numbers"},
+		{func() error { _, err := FromStruct[tagBadPattern](); return err }, "invalid pattern"},
+		{func() error { _, err := FromStruct[tagBadValue](); return err }, `requires an integer, got "two"`},
+		{func() error { _, err := FromStruct[string](); return err }, "requires a struct type"},
+	}
+
+	for _, tc := range testCases {
+		err := tc.build()
+		if err == nil {
+			t.Errorf("Expected error containing '%s'", tc.expected)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("Expected error containing '%s', got '%v'", tc.expected, err)
+		}
+	}
+}
+
+func TestMustFromStruct_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustFromStruct should panic on invalid tags")
+		}
+	}()
+	MustFromStruct[tagUnknownRule]()
+}
+
+func TestParseValidateTag_PatternWithCommas(t *testing.T) {
+	rules, err := parseValidateTag(`optional,maxLength=10,pattern=^\d{1,3}$`)
+	if err != nil {
+		t.Fatalf("parseValidateTag should accept the tag, got %v", err)
+	}
+	if !rules.optional || *rules.maxLength != 10 || rules.pattern != `^\d{1,3}$` {
+		t.Errorf("Unexpected rules %+v", rules)
+	}
+}
+
+func TestFromStruct_NumericFields(t *testing.T) {
+	type account struct {
+		Count   int         `json:"count"`
+		Balance float64     `json:"balance"`
+		ID      *big.Int    `json:"id"`
+		Amount  json.Number `json:"amount" validate:"min=0"`
+	}
+	validator := MustFromStruct[account]()
+
+	valid := map[string]any{"count": 2, "balance": 1.5, "id": json.Number("123456789012345678901"), "amount": json.Number("9.99")}
+	if result := validator.Validate(valid); !result.IsValid {
+		t.Errorf("Expected valid numbers, got %+v", result.Errors)
+	}
+
+	invalid := map[string]any{"count": 2.5, "balance": 1.5, "id": 1.5, "amount": json.Number("-1")}
+	if result := validator.Validate(invalid); len(result.Errors) != 3 {
+		t.Errorf("Expected 3 errors for fractional integers and negative amount, got %+v", result.Errors)
+	}
+}